@@ -0,0 +1,50 @@
+package tg
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrEmptyAllowedChatIDs = errors.New("empty allowed chat ids")
+	ErrChatNotAllowed      = errors.New("chat not allowed")
+)
+
+// WithAllowedChatIDs restricts the chats the messages are sent to, edited in and
+// deleted from, the requests to the other chats fail with ErrChatNotAllowed
+// before hitting the network.
+func WithAllowedChatIDs(ids ...int64) Option {
+	return func(cl *Client) error {
+		if len(ids) == 0 {
+			return ErrEmptyAllowedChatIDs
+		}
+
+		cl.allowedChats = make(map[int64]struct{}, len(ids))
+
+		for _, id := range ids {
+			if id == 0 {
+				return ErrEmptyChatID
+			}
+
+			cl.allowedChats[id] = struct{}{}
+		}
+
+		return nil
+	}
+}
+
+func (c *Client) allowChat(chatID int64) error {
+	if err := c.checkChatID(chatID); err != nil {
+		return err
+	}
+
+	if c.allowedChats == nil {
+		return nil
+	}
+
+	if _, ok := c.allowedChats[chatID]; !ok {
+		return fmt.Errorf("%w: %d", ErrChatNotAllowed, chatID)
+	}
+
+	return nil
+}
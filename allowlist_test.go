@@ -0,0 +1,105 @@
+//nolint:exhaustruct
+package tg
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_WithAllowedChatIDs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		ids    []int64
+		result error
+	}{
+		{
+			desc:   ErrEmptyAllowedChatIDs.Error(),
+			ids:    nil,
+			result: ErrEmptyAllowedChatIDs,
+		},
+		{
+			desc:   ErrEmptyChatID.Error(),
+			ids:    []int64{1, 0},
+			result: ErrEmptyChatID,
+		},
+		{
+			desc:   "nil_result",
+			ids:    []int64{1, -100},
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewClient(testToken, WithAllowedChatIDs(test.ids...))
+
+			assert.ErrorIs(t, err, test.result)
+		})
+	}
+}
+
+func Test_Client_AllowedChatIDs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		chatID int64
+		result error
+	}{
+		{
+			desc:   "allowed",
+			chatID: -100,
+			result: nil,
+		},
+		{
+			desc:   ErrChatNotAllowed.Error(),
+			chatID: 2,
+			result: ErrChatNotAllowed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			httpClient := &mockHTTPClient{}
+			httpClient.On("Do", mock.Anything).Return(func(req *http.Request) (*http.Response, error) {
+				body := `{"ok":true,"result":{"message_id":1}}`
+				if strings.HasSuffix(req.URL.Path, deleteMessageMethod) {
+					body = `{"ok":true,"result":true}`
+				}
+
+				return &http.Response{Body: io.NopCloser(bytes.NewBufferString(body))}, nil
+			})
+
+			client := new(Client)
+			client.http = httpClient
+
+			assert.NoError(t, WithAllowedChatIDs(1, -100)(client))
+
+			_, err := client.SendMessage(context.Background(), test.chatID, "test")
+			assert.ErrorIs(t, err, test.result)
+
+			_, err = client.EditMessage(context.Background(), test.chatID, 1, "test")
+			assert.ErrorIs(t, err, test.result)
+
+			_, err = client.DeleteMessage(context.Background(), test.chatID, 1)
+			assert.ErrorIs(t, err, test.result)
+
+			if test.result != nil {
+				httpClient.AssertNotCalled(t, "Do", mock.Anything)
+			}
+		})
+	}
+}
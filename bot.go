@@ -0,0 +1,276 @@
+package tg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+const (
+	MaxBotNameSize             int = 64
+	MaxBotDescriptionSize      int = 512
+	MaxBotShortDescriptionSize int = 120
+)
+
+var (
+	ErrIncorrectLanguageCode      = errors.New("incorrect language_code")
+	ErrBotNameTooLong             = errors.New("name too long")
+	ErrBotDescriptionTooLong      = errors.New("description too long")
+	ErrBotShortDescriptionTooLong = errors.New("short_description too long")
+)
+
+// BotLanguage is the language of the bot profile fields,
+// empty LanguageCode means the fields shown to the users without a dedicated one.
+type BotLanguage struct {
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+func (bl *BotLanguage) Validate() error {
+	if bl.LanguageCode == "" {
+		return nil
+	}
+
+	// two-letter ISO 639-1 code
+	if len(bl.LanguageCode) != 2 || //nolint:gomnd
+		!isLower(bl.LanguageCode[0]) || !isLower(bl.LanguageCode[1]) {
+		return ErrIncorrectLanguageCode
+	}
+
+	return nil
+}
+
+func isLower(b byte) bool {
+	return b >= 'a' && b <= 'z'
+}
+
+type BotProfileOption func(*BotLanguage)
+
+func LanguageCodeOption(code string) BotProfileOption {
+	return func(bl *BotLanguage) {
+		bl.LanguageCode = code
+	}
+}
+
+func newBotLanguage(opts ...BotProfileOption) BotLanguage {
+	bl := BotLanguage{}
+
+	for _, opt := range opts {
+		opt(&bl)
+	}
+
+	return bl
+}
+
+type SetMyName struct {
+	Name string `json:"name,omitempty"`
+	BotLanguage
+}
+
+func (sn *SetMyName) Validate() error {
+	if err := sn.BotLanguage.Validate(); err != nil {
+		return err
+	}
+
+	if utf8.RuneCountInString(sn.Name) > MaxBotNameSize {
+		return ErrBotNameTooLong
+	}
+
+	return nil
+}
+
+func NewSetMyName(name string, opts ...BotProfileOption) (*SetMyName, error) {
+	sn := new(SetMyName)
+
+	sn.BotLanguage = newBotLanguage(opts...)
+	sn.Name = name
+
+	if err := sn.Validate(); err != nil {
+		return nil, fmt.Errorf("SetMyName: %w", err)
+	}
+
+	return sn, nil
+}
+
+type SetMyDescription struct {
+	Description string `json:"description,omitempty"`
+	BotLanguage
+}
+
+func (sd *SetMyDescription) Validate() error {
+	if err := sd.BotLanguage.Validate(); err != nil {
+		return err
+	}
+
+	if utf8.RuneCountInString(sd.Description) > MaxBotDescriptionSize {
+		return ErrBotDescriptionTooLong
+	}
+
+	return nil
+}
+
+func NewSetMyDescription(description string, opts ...BotProfileOption) (*SetMyDescription, error) {
+	sd := new(SetMyDescription)
+
+	sd.BotLanguage = newBotLanguage(opts...)
+	sd.Description = description
+
+	if err := sd.Validate(); err != nil {
+		return nil, fmt.Errorf("SetMyDescription: %w", err)
+	}
+
+	return sd, nil
+}
+
+type SetMyShortDescription struct {
+	ShortDescription string `json:"short_description,omitempty"`
+	BotLanguage
+}
+
+func (ss *SetMyShortDescription) Validate() error {
+	if err := ss.BotLanguage.Validate(); err != nil {
+		return err
+	}
+
+	if utf8.RuneCountInString(ss.ShortDescription) > MaxBotShortDescriptionSize {
+		return ErrBotShortDescriptionTooLong
+	}
+
+	return nil
+}
+
+func NewSetMyShortDescription(shortDescription string, opts ...BotProfileOption) (*SetMyShortDescription, error) {
+	ss := new(SetMyShortDescription)
+
+	ss.BotLanguage = newBotLanguage(opts...)
+	ss.ShortDescription = shortDescription
+
+	if err := ss.Validate(); err != nil {
+		return nil, fmt.Errorf("SetMyShortDescription: %w", err)
+	}
+
+	return ss, nil
+}
+
+func NewBotLanguage(opts ...BotProfileOption) (*BotLanguage, error) {
+	bl := newBotLanguage(opts...)
+
+	if err := bl.Validate(); err != nil {
+		return nil, fmt.Errorf("BotLanguage: %w", err)
+	}
+
+	return &bl, nil
+}
+
+type BotName struct {
+	Name string `json:"name"`
+}
+
+type BotDescription struct {
+	Description string `json:"description"`
+}
+
+type BotShortDescription struct {
+	ShortDescription string `json:"short_description"`
+}
+
+const setMyNameMethod = "setMyName"
+
+func (c *Client) SetMyName(ctx context.Context, name string, opts ...BotProfileOption) (bool, error) {
+	req, err := NewSetMyName(name, opts...)
+	if err != nil {
+		return false, fmt.Errorf("SetMyName: %w", err)
+	}
+
+	resp, err := c.callBool(ctx, setMyNameMethod, req)
+	if err != nil {
+		return false, fmt.Errorf("SetMyName: %w", err)
+	}
+
+	return resp, nil
+}
+
+const getMyNameMethod = "getMyName"
+
+func (c *Client) GetMyName(ctx context.Context, opts ...BotProfileOption) (string, error) {
+	req, err := NewBotLanguage(opts...)
+	if err != nil {
+		return "", fmt.Errorf("GetMyName: %w", err)
+	}
+
+	resp := new(BotName)
+
+	if err := c.API(ctx, getMyNameMethod, req, resp); err != nil {
+		return "", fmt.Errorf("GetMyName: %w", err)
+	}
+
+	return resp.Name, nil
+}
+
+const setMyDescriptionMethod = "setMyDescription"
+
+func (c *Client) SetMyDescription(ctx context.Context, description string, opts ...BotProfileOption) (bool, error) {
+	req, err := NewSetMyDescription(description, opts...)
+	if err != nil {
+		return false, fmt.Errorf("SetMyDescription: %w", err)
+	}
+
+	resp, err := c.callBool(ctx, setMyDescriptionMethod, req)
+	if err != nil {
+		return false, fmt.Errorf("SetMyDescription: %w", err)
+	}
+
+	return resp, nil
+}
+
+const getMyDescriptionMethod = "getMyDescription"
+
+func (c *Client) GetMyDescription(ctx context.Context, opts ...BotProfileOption) (string, error) {
+	req, err := NewBotLanguage(opts...)
+	if err != nil {
+		return "", fmt.Errorf("GetMyDescription: %w", err)
+	}
+
+	resp := new(BotDescription)
+
+	if err := c.API(ctx, getMyDescriptionMethod, req, resp); err != nil {
+		return "", fmt.Errorf("GetMyDescription: %w", err)
+	}
+
+	return resp.Description, nil
+}
+
+const setMyShortDescriptionMethod = "setMyShortDescription"
+
+func (c *Client) SetMyShortDescription(ctx context.Context,
+	shortDescription string, opts ...BotProfileOption,
+) (bool, error) {
+	req, err := NewSetMyShortDescription(shortDescription, opts...)
+	if err != nil {
+		return false, fmt.Errorf("SetMyShortDescription: %w", err)
+	}
+
+	resp, err := c.callBool(ctx, setMyShortDescriptionMethod, req)
+	if err != nil {
+		return false, fmt.Errorf("SetMyShortDescription: %w", err)
+	}
+
+	return resp, nil
+}
+
+const getMyShortDescriptionMethod = "getMyShortDescription"
+
+func (c *Client) GetMyShortDescription(ctx context.Context, opts ...BotProfileOption) (string, error) {
+	req, err := NewBotLanguage(opts...)
+	if err != nil {
+		return "", fmt.Errorf("GetMyShortDescription: %w", err)
+	}
+
+	resp := new(BotShortDescription)
+
+	if err := c.API(ctx, getMyShortDescriptionMethod, req, resp); err != nil {
+		return "", fmt.Errorf("GetMyShortDescription: %w", err)
+	}
+
+	return resp.ShortDescription, nil
+}
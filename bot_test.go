@@ -0,0 +1,152 @@
+//nolint:exhaustruct
+package tg
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_BotLanguage_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		msg    func() *BotLanguage
+		result error
+	}{
+		{
+			desc: ErrIncorrectLanguageCode.Error(),
+			msg: func() *BotLanguage {
+				return &BotLanguage{LanguageCode: "eng"}
+			},
+			result: ErrIncorrectLanguageCode,
+		},
+		{
+			desc: ErrIncorrectLanguageCode.Error() + "_upper",
+			msg: func() *BotLanguage {
+				return &BotLanguage{LanguageCode: "EN"}
+			},
+			result: ErrIncorrectLanguageCode,
+		},
+		{
+			desc: "nil_result_empty",
+			msg: func() *BotLanguage {
+				return &BotLanguage{}
+			},
+			result: nil,
+		},
+		{
+			desc: "nil_result",
+			msg: func() *BotLanguage {
+				return &BotLanguage{LanguageCode: "en"}
+			},
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.msg().Validate(), test.result)
+		})
+	}
+}
+
+func Test_NewBotLanguage(t *testing.T) {
+	t.Parallel()
+
+	bl, err := NewBotLanguage(LanguageCodeOption("en"))
+	assert.NoError(t, err)
+	assert.Equal(t, &BotLanguage{LanguageCode: "en"}, bl)
+
+	_, err = NewBotLanguage(LanguageCodeOption("EN"))
+	assert.Equal(t, fmt.Errorf("BotLanguage: %w", ErrIncorrectLanguageCode), err)
+}
+
+func Test_BotProfile_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		msg    func() interface{ Validate() error }
+		result error
+	}{
+		{
+			desc: ErrBotNameTooLong.Error(),
+			msg: func() interface{ Validate() error } {
+				return &SetMyName{Name: strings.Repeat("я", MaxBotNameSize+1)}
+			},
+			result: ErrBotNameTooLong,
+		},
+		{
+			desc: ErrBotDescriptionTooLong.Error(),
+			msg: func() interface{ Validate() error } {
+				return &SetMyDescription{Description: strings.Repeat("я", MaxBotDescriptionSize+1)}
+			},
+			result: ErrBotDescriptionTooLong,
+		},
+		{
+			desc: ErrBotShortDescriptionTooLong.Error(),
+			msg: func() interface{ Validate() error } {
+				return &SetMyShortDescription{ShortDescription: strings.Repeat("я", MaxBotShortDescriptionSize+1)}
+			},
+			result: ErrBotShortDescriptionTooLong,
+		},
+		{
+			desc: ErrIncorrectLanguageCode.Error(),
+			msg: func() interface{ Validate() error } {
+				return &SetMyName{Name: "bot", BotLanguage: BotLanguage{LanguageCode: "x"}}
+			},
+			result: ErrIncorrectLanguageCode,
+		},
+		{
+			desc: "nil_result",
+			msg: func() interface{ Validate() error } {
+				return &SetMyShortDescription{ShortDescription: strings.Repeat("я", MaxBotShortDescriptionSize)}
+			},
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.msg().Validate(), test.result)
+		})
+	}
+}
+
+func Test_Client_GetMyName(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		body, _ := io.ReadAll(req.Body)
+
+		return strings.HasSuffix(req.URL.Path, "getMyName") &&
+			string(body) == `{"language_code":"en"}`
+	})).Return(
+		&http.Response{
+			Body: io.NopCloser(bytes.NewBufferString(`{"ok":true,"result":{"name":"Bot"}}`)),
+		},
+		nil,
+	)
+
+	client := new(Client)
+	client.http = httpClient
+
+	name, err := client.GetMyName(context.Background(), LanguageCodeOption("en"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Bot", name)
+}
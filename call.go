@@ -0,0 +1,45 @@
+package tg
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+var ErrEmptyMethod = errors.New("empty method")
+
+// Call invokes any Bot API method, including the ones without typed support.
+// The params are sent as JSON (InputFile uploads as multipart/form-data),
+// the result is decoded into result with json.Unmarshal, a nil result is ignored.
+//
+//	var count int
+//
+//	err := client.Call(ctx, "getChatMemberCount", map[string]any{"chat_id": chatID}, &count)
+func (c *Client) Call(ctx context.Context, method string, params map[string]any, result any) error {
+	if method == "" {
+		return fmt.Errorf("Call: %w", ErrEmptyMethod)
+	}
+
+	var req any
+
+	if params != nil {
+		req = &params
+	}
+
+	raw := new(json.RawMessage)
+
+	if err := c.API(ctx, method, req, raw); err != nil {
+		return fmt.Errorf("Call: %w", err)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(*raw, result); err != nil {
+		return fmt.Errorf("Call: json: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+//nolint:exhaustruct
+package tg
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Client_Call(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		body, _ := io.ReadAll(req.Body)
+
+		return req.URL.Path == "getChatMemberCount" && string(body) == `{"chat_id":1}`
+	})).Return(
+		&http.Response{
+			Body: io.NopCloser(bytes.NewBufferString(`{"ok":true,"result":42}`)),
+		},
+		nil,
+	)
+
+	client := new(Client)
+	client.http = httpClient
+
+	var count int
+
+	err := client.Call(context.Background(), "getChatMemberCount", map[string]any{"chat_id": 1}, &count)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 42, count)
+
+	err = client.Call(context.Background(), "", nil, nil)
+
+	assert.Equal(t, fmt.Errorf("Call: %w", ErrEmptyMethod), err)
+}
+
+func Test_Client_Call_Upload(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		if err := req.ParseMultipartForm(1 << 20); err != nil {
+			return false
+		}
+
+		_, header, err := req.FormFile("sticker")
+
+		return err == nil && header.Filename == "sticker.webp" && req.FormValue("user_id") == "1"
+	})).Return(
+		&http.Response{
+			Body: io.NopCloser(bytes.NewBufferString(`{"ok":true,"result":{"file_id":"test"}}`)),
+		},
+		nil,
+	)
+
+	client := new(Client)
+	client.http = httpClient
+
+	result := struct {
+		FileID string `json:"file_id"`
+	}{}
+
+	err := client.Call(context.Background(), "uploadStickerFile", map[string]any{
+		"user_id": 1,
+		"sticker": FileFromReader("sticker.webp", bytes.NewBufferString("sticker")),
+	}, &result)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "test", result.FileID)
+}
@@ -0,0 +1,994 @@
+package tg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	// the sizes are in UTF-16 code units, as MaxTextSize
+	MinChatTitleSize       int = 1
+	MaxChatTitleSize       int = 128
+	MaxChatDescriptionSize int = 255
+)
+
+var (
+	ErrEmptyChatTitle         = errors.New("empty title")
+	ErrChatTitleTooLong       = errors.New("title too long")
+	ErrChatDescriptionTooLong = errors.New("description too long")
+)
+
+type SetChatTitle struct {
+	ChatID int64  `json:"chat_id"`
+	Title  string `json:"title"`
+}
+
+func (st *SetChatTitle) Validate() error {
+	if st.ChatID == 0 {
+		return ErrEmptyChatID
+	}
+
+	size := utf16Len(st.Title)
+
+	if size < MinChatTitleSize {
+		return ErrEmptyChatTitle
+	}
+
+	if size > MaxChatTitleSize {
+		return ErrChatTitleTooLong
+	}
+
+	return nil
+}
+
+func NewSetChatTitle(chatID int64, title string) (*SetChatTitle, error) {
+	st := new(SetChatTitle)
+
+	st.ChatID = chatID
+	st.Title = title
+
+	if err := st.Validate(); err != nil {
+		return nil, fmt.Errorf("SetChatTitle: %w", err)
+	}
+
+	return st, nil
+}
+
+type SetChatDescription struct {
+	ChatID      int64  `json:"chat_id"`
+	Description string `json:"description,omitempty"`
+}
+
+func (sd *SetChatDescription) Validate() error {
+	if sd.ChatID == 0 {
+		return ErrEmptyChatID
+	}
+
+	if utf16Len(sd.Description) > MaxChatDescriptionSize {
+		return ErrChatDescriptionTooLong
+	}
+
+	return nil
+}
+
+func NewSetChatDescription(chatID int64, description string) (*SetChatDescription, error) {
+	sd := new(SetChatDescription)
+
+	sd.ChatID = chatID
+	sd.Description = description
+
+	if err := sd.Validate(); err != nil {
+		return nil, fmt.Errorf("SetChatDescription: %w", err)
+	}
+
+	return sd, nil
+}
+
+const setChatTitleMethod = "setChatTitle"
+
+func (c *Client) SetChatTitle(ctx context.Context, chatID int64, title string) (bool, error) {
+	req, err := NewSetChatTitle(chatID, title)
+	if err != nil {
+		return false, fmt.Errorf("SetChatTitle: %w", err)
+	}
+
+	resp, err := c.callBool(ctx, setChatTitleMethod, req)
+	if err != nil {
+		return false, fmt.Errorf("SetChatTitle: %w", err)
+	}
+
+	return resp, nil
+}
+
+const setChatDescriptionMethod = "setChatDescription"
+
+func (c *Client) SetChatDescription(ctx context.Context, chatID int64, description string) (bool, error) {
+	req, err := NewSetChatDescription(chatID, description)
+	if err != nil {
+		return false, fmt.Errorf("SetChatDescription: %w", err)
+	}
+
+	resp, err := c.callBool(ctx, setChatDescriptionMethod, req)
+	if err != nil {
+		return false, fmt.Errorf("SetChatDescription: %w", err)
+	}
+
+	return resp, nil
+}
+
+type ChatInviteLink struct {
+	InviteLink              string `json:"invite_link"`
+	Creator                 User   `json:"creator"`
+	CreatesJoinRequest      bool   `json:"creates_join_request"`
+	IsPrimary               bool   `json:"is_primary"`
+	IsRevoked               bool   `json:"is_revoked"`
+	Name                    string `json:"name,omitempty"`
+	ExpireDate              int    `json:"expire_date,omitempty"`
+	MemberLimit             int    `json:"member_limit,omitempty"`
+	PendingJoinRequestCount int    `json:"pending_join_request_count,omitempty"`
+}
+
+var ErrEmptyInviteLink = errors.New("empty invite_link")
+
+type RevokeChatInviteLink struct {
+	ChatID     int64  `json:"chat_id"`
+	InviteLink string `json:"invite_link"`
+}
+
+func (rl *RevokeChatInviteLink) Validate() error {
+	if rl.ChatID == 0 {
+		return ErrEmptyChatID
+	}
+
+	if rl.InviteLink == "" {
+		return ErrEmptyInviteLink
+	}
+
+	return nil
+}
+
+func NewRevokeChatInviteLink(chatID int64, inviteLink string) (*RevokeChatInviteLink, error) {
+	rl := new(RevokeChatInviteLink)
+
+	rl.ChatID = chatID
+	rl.InviteLink = inviteLink
+
+	if err := rl.Validate(); err != nil {
+		return nil, fmt.Errorf("RevokeChatInviteLink: %w", err)
+	}
+
+	return rl, nil
+}
+
+var ErrIncorrectUserID = errors.New("incorrect user_id")
+
+type ChatJoinRequest struct {
+	ChatID int64 `json:"chat_id"`
+	UserID int64 `json:"user_id"`
+}
+
+func (jr *ChatJoinRequest) Validate() error {
+	if jr.ChatID == 0 {
+		return ErrEmptyChatID
+	}
+
+	if jr.UserID <= 0 {
+		return ErrIncorrectUserID
+	}
+
+	return nil
+}
+
+func NewChatJoinRequest(chatID, userID int64) (*ChatJoinRequest, error) {
+	jr := new(ChatJoinRequest)
+
+	jr.ChatID = chatID
+	jr.UserID = userID
+
+	if err := jr.Validate(); err != nil {
+		return nil, fmt.Errorf("ChatJoinRequest: %w", err)
+	}
+
+	return jr, nil
+}
+
+const revokeChatInviteLinkMethod = "revokeChatInviteLink"
+
+func (c *Client) RevokeChatInviteLink(ctx context.Context,
+	chatID int64, inviteLink string,
+) (*ChatInviteLink, error) {
+	req, err := NewRevokeChatInviteLink(chatID, inviteLink)
+	if err != nil {
+		return nil, fmt.Errorf("RevokeChatInviteLink: %w", err)
+	}
+
+	resp := new(ChatInviteLink)
+
+	if err := c.API(ctx, revokeChatInviteLinkMethod, req, resp); err != nil {
+		return nil, fmt.Errorf("RevokeChatInviteLink: %w", err)
+	}
+
+	return resp, nil
+}
+
+const approveChatJoinRequestMethod = "approveChatJoinRequest"
+
+func (c *Client) ApproveChatJoinRequest(ctx context.Context, chatID, userID int64) (bool, error) {
+	req, err := NewChatJoinRequest(chatID, userID)
+	if err != nil {
+		return false, fmt.Errorf("ApproveChatJoinRequest: %w", err)
+	}
+
+	resp, err := c.callBool(ctx, approveChatJoinRequestMethod, req)
+	if err != nil {
+		return false, fmt.Errorf("ApproveChatJoinRequest: %w", err)
+	}
+
+	return resp, nil
+}
+
+const declineChatJoinRequestMethod = "declineChatJoinRequest"
+
+func (c *Client) DeclineChatJoinRequest(ctx context.Context, chatID, userID int64) (bool, error) {
+	req, err := NewChatJoinRequest(chatID, userID)
+	if err != nil {
+		return false, fmt.Errorf("DeclineChatJoinRequest: %w", err)
+	}
+
+	resp, err := c.callBool(ctx, declineChatJoinRequestMethod, req)
+	if err != nil {
+		return false, fmt.Errorf("DeclineChatJoinRequest: %w", err)
+	}
+
+	return resp, nil
+}
+
+type PromoteChatMember struct {
+	ChatID              int64 `json:"chat_id"`
+	UserID              int64 `json:"user_id"`
+	IsAnonymous         bool  `json:"is_anonymous,omitempty"`
+	CanManageChat       bool  `json:"can_manage_chat,omitempty"`
+	CanDeleteMessages   bool  `json:"can_delete_messages,omitempty"`
+	CanManageVideoChats bool  `json:"can_manage_video_chats,omitempty"`
+	CanRestrictMembers  bool  `json:"can_restrict_members,omitempty"`
+	CanPromoteMembers   bool  `json:"can_promote_members,omitempty"`
+	CanChangeInfo       bool  `json:"can_change_info,omitempty"`
+	CanInviteUsers      bool  `json:"can_invite_users,omitempty"`
+	CanPostMessages     bool  `json:"can_post_messages,omitempty"`
+	CanEditMessages     bool  `json:"can_edit_messages,omitempty"`
+	CanPinMessages      bool  `json:"can_pin_messages,omitempty"`
+	CanManageTopics     bool  `json:"can_manage_topics,omitempty"`
+}
+
+func (pm *PromoteChatMember) Validate() error {
+	if pm.ChatID == 0 {
+		return ErrEmptyChatID
+	}
+
+	if pm.UserID <= 0 {
+		return ErrIncorrectUserID
+	}
+
+	return nil
+}
+
+type PromoteOption func(*PromoteChatMember)
+
+func NewPromoteChatMember(chatID, userID int64, opts ...PromoteOption) (*PromoteChatMember, error) {
+	pm := new(PromoteChatMember)
+
+	for _, opt := range opts {
+		opt(pm)
+	}
+
+	pm.ChatID = chatID
+	pm.UserID = userID
+
+	if err := pm.Validate(); err != nil {
+		return nil, fmt.Errorf("PromoteChatMember: %w", err)
+	}
+
+	return pm, nil
+}
+
+func IsAnonymousPromoteOption(anonymous bool) PromoteOption {
+	return func(pm *PromoteChatMember) {
+		pm.IsAnonymous = anonymous
+	}
+}
+
+func CanManageChatPromoteOption(can bool) PromoteOption {
+	return func(pm *PromoteChatMember) {
+		pm.CanManageChat = can
+	}
+}
+
+func CanDeleteMessagesPromoteOption(can bool) PromoteOption {
+	return func(pm *PromoteChatMember) {
+		pm.CanDeleteMessages = can
+	}
+}
+
+func CanManageVideoChatsPromoteOption(can bool) PromoteOption {
+	return func(pm *PromoteChatMember) {
+		pm.CanManageVideoChats = can
+	}
+}
+
+func CanRestrictMembersPromoteOption(can bool) PromoteOption {
+	return func(pm *PromoteChatMember) {
+		pm.CanRestrictMembers = can
+	}
+}
+
+func CanPromoteMembersPromoteOption(can bool) PromoteOption {
+	return func(pm *PromoteChatMember) {
+		pm.CanPromoteMembers = can
+	}
+}
+
+func CanChangeInfoPromoteOption(can bool) PromoteOption {
+	return func(pm *PromoteChatMember) {
+		pm.CanChangeInfo = can
+	}
+}
+
+func CanInviteUsersPromoteOption(can bool) PromoteOption {
+	return func(pm *PromoteChatMember) {
+		pm.CanInviteUsers = can
+	}
+}
+
+func CanPostMessagesPromoteOption(can bool) PromoteOption {
+	return func(pm *PromoteChatMember) {
+		pm.CanPostMessages = can
+	}
+}
+
+func CanEditMessagesPromoteOption(can bool) PromoteOption {
+	return func(pm *PromoteChatMember) {
+		pm.CanEditMessages = can
+	}
+}
+
+func CanPinMessagesPromoteOption(can bool) PromoteOption {
+	return func(pm *PromoteChatMember) {
+		pm.CanPinMessages = can
+	}
+}
+
+func CanManageTopicsPromoteOption(can bool) PromoteOption {
+	return func(pm *PromoteChatMember) {
+		pm.CanManageTopics = can
+	}
+}
+
+type ChatPermissions struct {
+	CanSendMessages       bool `json:"can_send_messages"`
+	CanSendAudios         bool `json:"can_send_audios"`
+	CanSendDocuments      bool `json:"can_send_documents"`
+	CanSendPhotos         bool `json:"can_send_photos"`
+	CanSendVideos         bool `json:"can_send_videos"`
+	CanSendVideoNotes     bool `json:"can_send_video_notes"`
+	CanSendVoiceNotes     bool `json:"can_send_voice_notes"`
+	CanSendPolls          bool `json:"can_send_polls"`
+	CanSendOtherMessages  bool `json:"can_send_other_messages"`
+	CanAddWebPagePreviews bool `json:"can_add_web_page_previews"`
+	CanChangeInfo         bool `json:"can_change_info"`
+	CanInviteUsers        bool `json:"can_invite_users"`
+	CanPinMessages        bool `json:"can_pin_messages"`
+	CanManageTopics       bool `json:"can_manage_topics"`
+}
+
+type RestrictChatMember struct {
+	ChatID                        int64           `json:"chat_id"`
+	UserID                        int64           `json:"user_id"`
+	Permissions                   ChatPermissions `json:"permissions"`
+	UseIndependentChatPermissions bool            `json:"use_independent_chat_permissions,omitempty"`
+	UntilDate                     int64           `json:"until_date,omitempty"`
+}
+
+var ErrIncorrectUntilDate = errors.New("incorrect until_date")
+
+func (rm *RestrictChatMember) Validate() error {
+	if rm.ChatID == 0 {
+		return ErrEmptyChatID
+	}
+
+	if rm.UserID <= 0 {
+		return ErrIncorrectUserID
+	}
+
+	if rm.UntilDate < 0 {
+		return ErrIncorrectUntilDate
+	}
+
+	return nil
+}
+
+type RestrictOption func(*RestrictChatMember)
+
+func NewRestrictChatMember(chatID, userID int64,
+	permissions ChatPermissions, opts ...RestrictOption,
+) (*RestrictChatMember, error) {
+	rm := new(RestrictChatMember)
+
+	for _, opt := range opts {
+		opt(rm)
+	}
+
+	rm.ChatID = chatID
+	rm.UserID = userID
+	rm.Permissions = permissions
+
+	if err := rm.Validate(); err != nil {
+		return nil, fmt.Errorf("RestrictChatMember: %w", err)
+	}
+
+	return rm, nil
+}
+
+func UntilDateRestrictOption(until time.Time) RestrictOption {
+	return func(rm *RestrictChatMember) {
+		rm.UntilDate = until.Unix()
+	}
+}
+
+func UseIndependentChatPermissionsRestrictOption(independent bool) RestrictOption {
+	return func(rm *RestrictChatMember) {
+		rm.UseIndependentChatPermissions = independent
+	}
+}
+
+const promoteChatMemberMethod = "promoteChatMember"
+
+func (c *Client) PromoteChatMember(ctx context.Context,
+	chatID, userID int64, opts ...PromoteOption,
+) (bool, error) {
+	req, err := NewPromoteChatMember(chatID, userID, opts...)
+	if err != nil {
+		return false, fmt.Errorf("PromoteChatMember: %w", err)
+	}
+
+	resp, err := c.callBool(ctx, promoteChatMemberMethod, req)
+	if err != nil {
+		return false, fmt.Errorf("PromoteChatMember: %w", err)
+	}
+
+	return resp, nil
+}
+
+const restrictChatMemberMethod = "restrictChatMember"
+
+func (c *Client) RestrictChatMember(ctx context.Context,
+	chatID, userID int64, permissions ChatPermissions, opts ...RestrictOption,
+) (bool, error) {
+	req, err := NewRestrictChatMember(chatID, userID, permissions, opts...)
+	if err != nil {
+		return false, fmt.Errorf("RestrictChatMember: %w", err)
+	}
+
+	resp, err := c.callBool(ctx, restrictChatMemberMethod, req)
+	if err != nil {
+		return false, fmt.Errorf("RestrictChatMember: %w", err)
+	}
+
+	return resp, nil
+}
+
+type PinChatMessage struct {
+	ChatID              int64 `json:"chat_id"`
+	MessageID           int64 `json:"message_id"`
+	DisableNotification bool  `json:"disable_notification,omitempty"`
+}
+
+func (pm *PinChatMessage) Validate() error {
+	if pm.ChatID == 0 {
+		return ErrEmptyChatID
+	}
+
+	if pm.MessageID <= 0 {
+		return ErrIncorrectMessageID
+	}
+
+	return nil
+}
+
+type PinOption func(*PinChatMessage)
+
+func NewPinChatMessage(chatID, messageID int64, opts ...PinOption) (*PinChatMessage, error) {
+	pm := new(PinChatMessage)
+
+	for _, opt := range opts {
+		opt(pm)
+	}
+
+	pm.ChatID = chatID
+	pm.MessageID = messageID
+
+	if err := pm.Validate(); err != nil {
+		return nil, fmt.Errorf("PinChatMessage: %w", err)
+	}
+
+	return pm, nil
+}
+
+func DisableNotificationPinOption(disable bool) PinOption {
+	return func(pm *PinChatMessage) {
+		pm.DisableNotification = disable
+	}
+}
+
+const pinChatMessageMethod = "pinChatMessage"
+
+func (c *Client) PinChatMessage(ctx context.Context, chatID, messageID int64, opts ...PinOption) (bool, error) {
+	req, err := NewPinChatMessage(chatID, messageID, opts...)
+	if err != nil {
+		return false, fmt.Errorf("PinChatMessage: %w", err)
+	}
+
+	resp, err := c.callBool(ctx, pinChatMessageMethod, req)
+	if err != nil {
+		return false, fmt.Errorf("PinChatMessage: %w", err)
+	}
+
+	return resp, nil
+}
+
+type UnpinAllChatMessages struct {
+	ChatID int64 `json:"chat_id"`
+}
+
+func (ua *UnpinAllChatMessages) Validate() error {
+	if ua.ChatID == 0 {
+		return ErrEmptyChatID
+	}
+
+	return nil
+}
+
+func NewUnpinAllChatMessages(chatID int64) (*UnpinAllChatMessages, error) {
+	ua := new(UnpinAllChatMessages)
+
+	ua.ChatID = chatID
+
+	if err := ua.Validate(); err != nil {
+		return nil, fmt.Errorf("UnpinAllChatMessages: %w", err)
+	}
+
+	return ua, nil
+}
+
+const unpinAllChatMessagesMethod = "unpinAllChatMessages"
+
+func (c *Client) UnpinAllChatMessages(ctx context.Context, chatID int64) (bool, error) {
+	req, err := NewUnpinAllChatMessages(chatID)
+	if err != nil {
+		return false, fmt.Errorf("UnpinAllChatMessages: %w", err)
+	}
+
+	resp, err := c.callBool(ctx, unpinAllChatMessagesMethod, req)
+	if err != nil {
+		return false, fmt.Errorf("UnpinAllChatMessages: %w", err)
+	}
+
+	return resp, nil
+}
+
+type LeaveChat struct {
+	ChatID int64 `json:"chat_id"`
+}
+
+func (lc *LeaveChat) Validate() error {
+	if lc.ChatID == 0 {
+		return ErrEmptyChatID
+	}
+
+	return nil
+}
+
+func NewLeaveChat(chatID int64) (*LeaveChat, error) {
+	lc := new(LeaveChat)
+
+	lc.ChatID = chatID
+
+	if err := lc.Validate(); err != nil {
+		return nil, fmt.Errorf("LeaveChat: %w", err)
+	}
+
+	return lc, nil
+}
+
+const leaveChatMethod = "leaveChat"
+
+func (c *Client) LeaveChat(ctx context.Context, chatID int64) (bool, error) {
+	req, err := NewLeaveChat(chatID)
+	if err != nil {
+		return false, fmt.Errorf("LeaveChat: %w", err)
+	}
+
+	resp, err := c.callBool(ctx, leaveChatMethod, req)
+	if err != nil {
+		return false, fmt.Errorf("LeaveChat: %w", err)
+	}
+
+	return resp, nil
+}
+
+type ChatMemberStatus string
+
+const (
+	CreatorChatMemberStatus       = "creator"
+	AdministratorChatMemberStatus = "administrator"
+	MemberChatMemberStatus        = "member"
+	RestrictedChatMemberStatus    = "restricted"
+	LeftChatMemberStatus          = "left"
+	KickedChatMemberStatus        = "kicked"
+)
+
+// ChatMember is a flattened union of the chat member variants,
+// the set fields depend on Status. IsMember and the CanSend fields are set
+// for the restricted members, UntilDate is the unix time the restriction
+// or the ban is lifted (0 means forever).
+type ChatMember struct {
+	Status                ChatMemberStatus `json:"status"`
+	User                  User             `json:"user"`
+	IsAnonymous           bool             `json:"is_anonymous,omitempty"`
+	CustomTitle           string           `json:"custom_title,omitempty"`
+	CanBeEdited           bool             `json:"can_be_edited,omitempty"`
+	CanManageChat         bool             `json:"can_manage_chat,omitempty"`
+	CanDeleteMessages     bool             `json:"can_delete_messages,omitempty"`
+	CanManageVideoChats   bool             `json:"can_manage_video_chats,omitempty"`
+	CanRestrictMembers    bool             `json:"can_restrict_members,omitempty"`
+	CanPromoteMembers     bool             `json:"can_promote_members,omitempty"`
+	CanChangeInfo         bool             `json:"can_change_info,omitempty"`
+	CanInviteUsers        bool             `json:"can_invite_users,omitempty"`
+	CanPostMessages       bool             `json:"can_post_messages,omitempty"`
+	CanEditMessages       bool             `json:"can_edit_messages,omitempty"`
+	CanPinMessages        bool             `json:"can_pin_messages,omitempty"`
+	CanManageTopics       bool             `json:"can_manage_topics,omitempty"`
+	IsMember              bool             `json:"is_member,omitempty"`
+	CanSendMessages       bool             `json:"can_send_messages,omitempty"`
+	CanSendAudios         bool             `json:"can_send_audios,omitempty"`
+	CanSendDocuments      bool             `json:"can_send_documents,omitempty"`
+	CanSendPhotos         bool             `json:"can_send_photos,omitempty"`
+	CanSendVideos         bool             `json:"can_send_videos,omitempty"`
+	CanSendVideoNotes     bool             `json:"can_send_video_notes,omitempty"`
+	CanSendVoiceNotes     bool             `json:"can_send_voice_notes,omitempty"`
+	CanSendPolls          bool             `json:"can_send_polls,omitempty"`
+	CanSendOtherMessages  bool             `json:"can_send_other_messages,omitempty"`
+	CanAddWebPagePreviews bool             `json:"can_add_web_page_previews,omitempty"`
+	UntilDate             int64            `json:"until_date,omitempty"`
+}
+
+// InChat reports whether the user is a member of the chat, including
+// the owner, the administrators and the restricted members.
+func (cm *ChatMember) InChat() bool {
+	switch cm.Status {
+	case CreatorChatMemberStatus, AdministratorChatMemberStatus, MemberChatMemberStatus:
+		return true
+	case RestrictedChatMemberStatus:
+		return cm.IsMember
+	default:
+		return false
+	}
+}
+
+type GetChatMember struct {
+	ChatID int64 `json:"chat_id"`
+	UserID int64 `json:"user_id"`
+}
+
+func (gm *GetChatMember) Validate() error {
+	if gm.ChatID == 0 {
+		return ErrEmptyChatID
+	}
+
+	if gm.UserID <= 0 {
+		return ErrIncorrectUserID
+	}
+
+	return nil
+}
+
+func NewGetChatMember(chatID, userID int64) (*GetChatMember, error) {
+	gm := new(GetChatMember)
+
+	gm.ChatID = chatID
+	gm.UserID = userID
+
+	if err := gm.Validate(); err != nil {
+		return nil, fmt.Errorf("GetChatMember: %w", err)
+	}
+
+	return gm, nil
+}
+
+const getChatMemberMethod = "getChatMember"
+
+func (c *Client) GetChatMember(ctx context.Context, chatID, userID int64) (*ChatMember, error) {
+	req, err := NewGetChatMember(chatID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("GetChatMember: %w", err)
+	}
+
+	resp := new(ChatMember)
+
+	if err := c.API(ctx, getChatMemberMethod, req, resp); err != nil {
+		return nil, fmt.Errorf("GetChatMember: %w", err)
+	}
+
+	return resp, nil
+}
+
+type GetChatAdministrators struct {
+	ChatID int64 `json:"chat_id"`
+}
+
+func (ga *GetChatAdministrators) Validate() error {
+	if ga.ChatID == 0 {
+		return ErrEmptyChatID
+	}
+
+	return nil
+}
+
+func NewGetChatAdministrators(chatID int64) (*GetChatAdministrators, error) {
+	ga := new(GetChatAdministrators)
+
+	ga.ChatID = chatID
+
+	if err := ga.Validate(); err != nil {
+		return nil, fmt.Errorf("GetChatAdministrators: %w", err)
+	}
+
+	return ga, nil
+}
+
+const getChatAdministratorsMethod = "getChatAdministrators"
+
+func (c *Client) GetChatAdministrators(ctx context.Context, chatID int64) ([]ChatMember, error) {
+	req, err := NewGetChatAdministrators(chatID)
+	if err != nil {
+		return nil, fmt.Errorf("GetChatAdministrators: %w", err)
+	}
+
+	resp := make([]ChatMember, 0)
+
+	if err := c.API(ctx, getChatAdministratorsMethod, req, &resp); err != nil {
+		return nil, fmt.Errorf("GetChatAdministrators: %w", err)
+	}
+
+	return resp, nil
+}
+
+var ErrPhotoNil = errors.New("photo is nil")
+
+type SetChatPhoto struct {
+	ChatID int64     `json:"chat_id"`
+	Photo  InputFile `json:"photo"`
+}
+
+func (sp *SetChatPhoto) Validate() error {
+	if sp.ChatID == 0 {
+		return ErrEmptyChatID
+	}
+
+	if !sp.Photo.IsUpload() {
+		return ErrPhotoNil
+	}
+
+	return nil
+}
+
+func NewSetChatPhoto(chatID int64, photo io.Reader, filename string) (*SetChatPhoto, error) {
+	sp := new(SetChatPhoto)
+
+	sp.ChatID = chatID
+
+	if photo != nil {
+		sp.Photo = FileFromReader(filename, photo)
+	}
+
+	if err := sp.Validate(); err != nil {
+		return nil, fmt.Errorf("SetChatPhoto: %w", err)
+	}
+
+	return sp, nil
+}
+
+type DeleteChatPhoto struct {
+	ChatID int64 `json:"chat_id"`
+}
+
+func (dp *DeleteChatPhoto) Validate() error {
+	if dp.ChatID == 0 {
+		return ErrEmptyChatID
+	}
+
+	return nil
+}
+
+func NewDeleteChatPhoto(chatID int64) (*DeleteChatPhoto, error) {
+	dp := new(DeleteChatPhoto)
+
+	dp.ChatID = chatID
+
+	if err := dp.Validate(); err != nil {
+		return nil, fmt.Errorf("DeleteChatPhoto: %w", err)
+	}
+
+	return dp, nil
+}
+
+const setChatPhotoMethod = "setChatPhoto"
+
+// SetChatPhoto uploads a new chat photo, photos can't be set by URL or file_id.
+func (c *Client) SetChatPhoto(ctx context.Context, chatID int64, photo io.Reader, filename string) (bool, error) {
+	req, err := NewSetChatPhoto(chatID, photo, filename)
+	if err != nil {
+		return false, fmt.Errorf("SetChatPhoto: %w", err)
+	}
+
+	resp, err := c.callBool(ctx, setChatPhotoMethod, req)
+	if err != nil {
+		return false, fmt.Errorf("SetChatPhoto: %w", err)
+	}
+
+	return resp, nil
+}
+
+const deleteChatPhotoMethod = "deleteChatPhoto"
+
+func (c *Client) DeleteChatPhoto(ctx context.Context, chatID int64) (bool, error) {
+	req, err := NewDeleteChatPhoto(chatID)
+	if err != nil {
+		return false, fmt.Errorf("DeleteChatPhoto: %w", err)
+	}
+
+	resp, err := c.callBool(ctx, deleteChatPhotoMethod, req)
+	if err != nil {
+		return false, fmt.Errorf("DeleteChatPhoto: %w", err)
+	}
+
+	return resp, nil
+}
+
+// PinError is returned by SendAndPin if the message was sent but not pinned.
+type PinError struct {
+	MessageID int64
+	Err       error
+}
+
+func (e *PinError) Error() string {
+	return fmt.Sprintf("message_id %d: %s", e.MessageID, e.Err)
+}
+
+func (e *PinError) Unwrap() error {
+	return e.Err
+}
+
+// SendAndPin sends a message and pins it, the pin notification is disabled
+// with DisableNotificationSendOption. Telegram has no atomic primitive for it,
+// so if pinning fails the sent message is returned along with a *PinError.
+func (c *Client) SendAndPin(ctx context.Context,
+	chatID int64, text string, opts ...SendOption,
+) (*Message, error) {
+	req, err := NewSendMessage(chatID, text, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("SendAndPin: %w", err)
+	}
+
+	msg, err := c.SendMessage(ctx, chatID, text, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("SendAndPin: %w", err)
+	}
+
+	_, err = c.PinChatMessage(ctx, chatID, msg.MessageID,
+		DisableNotificationPinOption(req.DisableNotification),
+	)
+	if err != nil {
+		return msg, fmt.Errorf("SendAndPin: %w", &PinError{MessageID: msg.MessageID, Err: err})
+	}
+
+	return msg, nil
+}
+
+// GetChat is the getChat request, ChatID is an int64 id or a "@username" string.
+type GetChat struct {
+	ChatID any `json:"chat_id"`
+}
+
+var ErrIncorrectUsername = errors.New("incorrect username")
+
+func (gc *GetChat) Validate() error {
+	switch chatID := gc.ChatID.(type) {
+	case int64:
+		if chatID == 0 {
+			return ErrEmptyChatID
+		}
+	case string:
+		if len(chatID) < 2 || !strings.HasPrefix(chatID, "@") { //nolint:gomnd
+			return ErrIncorrectUsername
+		}
+	default:
+		return ErrEmptyChatID
+	}
+
+	return nil
+}
+
+func NewGetChat(chatID int64) (*GetChat, error) {
+	gc := new(GetChat)
+
+	gc.ChatID = chatID
+
+	if err := gc.Validate(); err != nil {
+		return nil, fmt.Errorf("GetChat: %w", err)
+	}
+
+	return gc, nil
+}
+
+// NewGetChatByUsername creates a getChat request for a public chat, the "@" prefix is optional.
+func NewGetChatByUsername(username string) (*GetChat, error) {
+	gc := new(GetChat)
+
+	if !strings.HasPrefix(username, "@") {
+		username = "@" + username
+	}
+
+	gc.ChatID = username
+
+	if err := gc.Validate(); err != nil {
+		return nil, fmt.Errorf("GetChat: %w", err)
+	}
+
+	return gc, nil
+}
+
+const getChatMethod = "getChat"
+
+func (c *Client) getChat(ctx context.Context, req *GetChat) (*Chat, error) {
+	resp := new(Chat)
+
+	if err := c.API(ctx, getChatMethod, req, resp); err != nil {
+		return nil, err
+	}
+
+	c.cacheChat(resp)
+
+	return resp, nil
+}
+
+func (c *Client) GetChat(ctx context.Context, chatID int64) (*Chat, error) {
+	req, err := NewGetChat(chatID)
+	if err != nil {
+		return nil, fmt.Errorf("GetChat: %w", err)
+	}
+
+	resp, err := c.getChat(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("GetChat: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) GetChatByUsername(ctx context.Context, username string) (*Chat, error) {
+	req, err := NewGetChatByUsername(username)
+	if err != nil {
+		return nil, fmt.Errorf("GetChatByUsername: %w", err)
+	}
+
+	resp, err := c.getChat(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("GetChatByUsername: %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,634 @@
+//nolint:exhaustruct
+package tg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_SetChatTitle_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		msg    func() *SetChatTitle
+		result error
+	}{
+		{
+			desc:   ErrEmptyChatID.Error(),
+			msg:    func() *SetChatTitle { return &SetChatTitle{} },
+			result: ErrEmptyChatID,
+		},
+		{
+			desc:   ErrEmptyChatTitle.Error(),
+			msg:    func() *SetChatTitle { return &SetChatTitle{ChatID: 1} },
+			result: ErrEmptyChatTitle,
+		},
+		{
+			desc: ErrChatTitleTooLong.Error(),
+			msg: func() *SetChatTitle {
+				return &SetChatTitle{
+					ChatID: 1,
+					Title:  strings.Repeat("т", MaxChatTitleSize+1),
+				}
+			},
+			result: ErrChatTitleTooLong,
+		},
+		{
+			desc: "surrogate_pairs",
+			msg: func() *SetChatTitle {
+				return &SetChatTitle{
+					ChatID: 1,
+					Title:  strings.Repeat("😀", MaxChatTitleSize/2+1),
+				}
+			},
+			result: ErrChatTitleTooLong,
+		},
+		{
+			desc: "nil_result",
+			msg: func() *SetChatTitle {
+				return &SetChatTitle{
+					ChatID: 1,
+					Title:  strings.Repeat("т", MaxChatTitleSize),
+				}
+			},
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.msg().Validate(), test.result)
+		})
+	}
+}
+
+func Test_SetChatDescription_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		msg    func() *SetChatDescription
+		result error
+	}{
+		{
+			desc:   ErrEmptyChatID.Error(),
+			msg:    func() *SetChatDescription { return &SetChatDescription{} },
+			result: ErrEmptyChatID,
+		},
+		{
+			desc: ErrChatDescriptionTooLong.Error(),
+			msg: func() *SetChatDescription {
+				return &SetChatDescription{
+					ChatID:      1,
+					Description: strings.Repeat("т", MaxChatDescriptionSize+1),
+				}
+			},
+			result: ErrChatDescriptionTooLong,
+		},
+		{
+			desc: "surrogate_pairs",
+			msg: func() *SetChatDescription {
+				return &SetChatDescription{
+					ChatID:      1,
+					Description: strings.Repeat("😀", MaxChatDescriptionSize/2+1),
+				}
+			},
+			result: ErrChatDescriptionTooLong,
+		},
+		{
+			desc:   "nil_result",
+			msg:    func() *SetChatDescription { return &SetChatDescription{ChatID: 1} },
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.msg().Validate(), test.result)
+		})
+	}
+}
+
+func Test_RevokeChatInviteLink_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		msg    func() *RevokeChatInviteLink
+		result error
+	}{
+		{
+			desc:   ErrEmptyChatID.Error(),
+			msg:    func() *RevokeChatInviteLink { return &RevokeChatInviteLink{} },
+			result: ErrEmptyChatID,
+		},
+		{
+			desc:   ErrEmptyInviteLink.Error(),
+			msg:    func() *RevokeChatInviteLink { return &RevokeChatInviteLink{ChatID: 1} },
+			result: ErrEmptyInviteLink,
+		},
+		{
+			desc: "nil_result",
+			msg: func() *RevokeChatInviteLink {
+				return &RevokeChatInviteLink{
+					ChatID:     1,
+					InviteLink: "https://t.me/+test",
+				}
+			},
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.msg().Validate(), test.result)
+		})
+	}
+}
+
+func Test_ChatJoinRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		msg    func() *ChatJoinRequest
+		result error
+	}{
+		{
+			desc:   ErrEmptyChatID.Error(),
+			msg:    func() *ChatJoinRequest { return &ChatJoinRequest{} },
+			result: ErrEmptyChatID,
+		},
+		{
+			desc: ErrIncorrectUserID.Error(),
+			msg: func() *ChatJoinRequest {
+				return &ChatJoinRequest{
+					ChatID: 1,
+					UserID: -1,
+				}
+			},
+			result: ErrIncorrectUserID,
+		},
+		{
+			desc: "nil_result",
+			msg: func() *ChatJoinRequest {
+				return &ChatJoinRequest{
+					ChatID: 1,
+					UserID: 1,
+				}
+			},
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.msg().Validate(), test.result)
+		})
+	}
+}
+
+func Test_PromoteChatMember_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		msg    func() *PromoteChatMember
+		result error
+	}{
+		{
+			desc:   ErrEmptyChatID.Error(),
+			msg:    func() *PromoteChatMember { return &PromoteChatMember{} },
+			result: ErrEmptyChatID,
+		},
+		{
+			desc:   ErrIncorrectUserID.Error(),
+			msg:    func() *PromoteChatMember { return &PromoteChatMember{ChatID: 1} },
+			result: ErrIncorrectUserID,
+		},
+		{
+			desc: "nil_result",
+			msg: func() *PromoteChatMember {
+				return &PromoteChatMember{
+					ChatID:         1,
+					UserID:         1,
+					CanPinMessages: true,
+				}
+			},
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.msg().Validate(), test.result)
+		})
+	}
+}
+
+func Test_RestrictChatMember_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		msg    func() *RestrictChatMember
+		result error
+	}{
+		{
+			desc:   ErrEmptyChatID.Error(),
+			msg:    func() *RestrictChatMember { return &RestrictChatMember{} },
+			result: ErrEmptyChatID,
+		},
+		{
+			desc:   ErrIncorrectUserID.Error(),
+			msg:    func() *RestrictChatMember { return &RestrictChatMember{ChatID: 1} },
+			result: ErrIncorrectUserID,
+		},
+		{
+			desc: ErrIncorrectUntilDate.Error(),
+			msg: func() *RestrictChatMember {
+				return &RestrictChatMember{
+					ChatID:    1,
+					UserID:    1,
+					UntilDate: -1,
+				}
+			},
+			result: ErrIncorrectUntilDate,
+		},
+		{
+			desc: "nil_result",
+			msg: func() *RestrictChatMember {
+				return &RestrictChatMember{
+					ChatID: 1,
+					UserID: 1,
+					Permissions: ChatPermissions{
+						CanSendMessages: true,
+					},
+				}
+			},
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.msg().Validate(), test.result)
+		})
+	}
+}
+
+func Test_PinChatMessage_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		msg    func() *PinChatMessage
+		result error
+	}{
+		{
+			desc:   ErrEmptyChatID.Error(),
+			msg:    func() *PinChatMessage { return &PinChatMessage{} },
+			result: ErrEmptyChatID,
+		},
+		{
+			desc:   ErrIncorrectMessageID.Error(),
+			msg:    func() *PinChatMessage { return &PinChatMessage{ChatID: 1} },
+			result: ErrIncorrectMessageID,
+		},
+		{
+			desc: "nil_result",
+			msg: func() *PinChatMessage {
+				return &PinChatMessage{
+					ChatID:              1,
+					MessageID:           1,
+					DisableNotification: true,
+				}
+			},
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.msg().Validate(), test.result)
+		})
+	}
+}
+
+func Test_UnpinAllChatMessages_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		msg    func() *UnpinAllChatMessages
+		result error
+	}{
+		{
+			desc:   ErrEmptyChatID.Error(),
+			msg:    func() *UnpinAllChatMessages { return &UnpinAllChatMessages{} },
+			result: ErrEmptyChatID,
+		},
+		{
+			desc:   "nil_result",
+			msg:    func() *UnpinAllChatMessages { return &UnpinAllChatMessages{ChatID: -1} },
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.msg().Validate(), test.result)
+		})
+	}
+}
+
+func Test_LeaveChat_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		msg    func() *LeaveChat
+		result error
+	}{
+		{
+			desc:   ErrEmptyChatID.Error(),
+			msg:    func() *LeaveChat { return &LeaveChat{} },
+			result: ErrEmptyChatID,
+		},
+		{
+			desc:   "nil_result",
+			msg:    func() *LeaveChat { return &LeaveChat{ChatID: -1} },
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.msg().Validate(), test.result)
+		})
+	}
+}
+
+func Test_Client_GetChatAdministrators(t *testing.T) {
+	t.Parallel()
+
+	body := `{"ok":true,"result":[` +
+		`{"status":"creator","user":{"id":1,"first_name":"owner"},"is_anonymous":false,"custom_title":"boss"},` +
+		`{"status":"administrator","user":{"id":2,"first_name":"bot"},"can_be_edited":false,` +
+		`"can_delete_messages":true,"can_pin_messages":true}` +
+		`]}`
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.Anything).Return(
+		&http.Response{
+			Body: io.NopCloser(bytes.NewBufferString(body)),
+		},
+		nil,
+	)
+
+	client := new(Client)
+	client.http = httpClient
+
+	members, err := client.GetChatAdministrators(context.Background(), -1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []ChatMember{
+		{
+			Status:      CreatorChatMemberStatus,
+			User:        User{ID: 1, FirstName: "owner"},
+			CustomTitle: "boss",
+		},
+		{
+			Status:            AdministratorChatMemberStatus,
+			User:              User{ID: 2, FirstName: "bot"},
+			CanDeleteMessages: true,
+			CanPinMessages:    true,
+		},
+	}, members)
+}
+
+func Test_GetChatMember_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		msg    func() *GetChatMember
+		result error
+	}{
+		{
+			desc:   ErrEmptyChatID.Error(),
+			msg:    func() *GetChatMember { return &GetChatMember{} },
+			result: ErrEmptyChatID,
+		},
+		{
+			desc:   ErrIncorrectUserID.Error(),
+			msg:    func() *GetChatMember { return &GetChatMember{ChatID: 1, UserID: -1} },
+			result: ErrIncorrectUserID,
+		},
+		{
+			desc:   "nil_result",
+			msg:    func() *GetChatMember { return &GetChatMember{ChatID: -1, UserID: 1} },
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.msg().Validate(), test.result)
+		})
+	}
+}
+
+func Test_Client_GetChatMember(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		body, _ := io.ReadAll(req.Body)
+
+		return req.URL.String() == getChatMemberMethod && string(body) == `{"chat_id":-1,"user_id":2}`
+	})).Return(
+		&http.Response{
+			Body: io.NopCloser(bytes.NewBufferString(`{"ok":true,"result":{"status":"restricted",` +
+				`"user":{"id":2,"first_name":"user"},"is_member":true,"can_send_messages":true,"until_date":1700000000}}`)),
+		},
+		nil,
+	)
+
+	client := new(Client)
+	client.http = httpClient
+
+	member, err := client.GetChatMember(context.Background(), -1, 2)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &ChatMember{
+		Status:          RestrictedChatMemberStatus,
+		User:            User{ID: 2, FirstName: "user"},
+		IsMember:        true,
+		CanSendMessages: true,
+		UntilDate:       1700000000,
+	}, member)
+	assert.True(t, member.InChat())
+}
+
+func Test_ChatMember_InChat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		member ChatMember
+		result bool
+	}{
+		{desc: "creator", member: ChatMember{Status: CreatorChatMemberStatus}, result: true},
+		{desc: "member", member: ChatMember{Status: MemberChatMemberStatus}, result: true},
+		{desc: "restricted_member", member: ChatMember{Status: RestrictedChatMemberStatus, IsMember: true}, result: true},
+		{desc: "restricted_left", member: ChatMember{Status: RestrictedChatMemberStatus}, result: false},
+		{desc: "left", member: ChatMember{Status: LeftChatMemberStatus}, result: false},
+		{desc: "kicked", member: ChatMember{Status: KickedChatMemberStatus}, result: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.result, test.member.InChat())
+		})
+	}
+}
+
+func Test_SetChatPhoto_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		msg    func() *SetChatPhoto
+		result error
+	}{
+		{
+			desc:   ErrEmptyChatID.Error(),
+			msg:    func() *SetChatPhoto { return &SetChatPhoto{} },
+			result: ErrEmptyChatID,
+		},
+		{
+			desc:   ErrPhotoNil.Error(),
+			msg:    func() *SetChatPhoto { return &SetChatPhoto{ChatID: 1} },
+			result: ErrPhotoNil,
+		},
+		{
+			desc: "nil_result",
+			msg: func() *SetChatPhoto {
+				return &SetChatPhoto{
+					ChatID: 1,
+					Photo:  FileFromReader("photo.jpg", bytes.NewReader(nil)),
+				}
+			},
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.msg().Validate(), test.result)
+		})
+	}
+}
+
+func Test_DeleteChatPhoto_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		msg    func() *DeleteChatPhoto
+		result error
+	}{
+		{
+			desc:   ErrEmptyChatID.Error(),
+			msg:    func() *DeleteChatPhoto { return &DeleteChatPhoto{} },
+			result: ErrEmptyChatID,
+		},
+		{
+			desc:   "nil_result",
+			msg:    func() *DeleteChatPhoto { return &DeleteChatPhoto{ChatID: 1} },
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.msg().Validate(), test.result)
+		})
+	}
+}
+
+func Test_Client_SendAndPin(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		return strings.HasSuffix(req.URL.Path, sendMessageMethod)
+	})).Return(
+		&http.Response{
+			Body: io.NopCloser(bytes.NewBufferString(`{"ok":true,"result":{"message_id":7}}`)),
+		},
+		nil,
+	)
+	httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		pm := new(PinChatMessage)
+
+		return strings.HasSuffix(req.URL.Path, pinChatMessageMethod) &&
+			json.NewDecoder(req.Body).Decode(pm) == nil &&
+			pm.MessageID == 7 && pm.DisableNotification
+	})).Return(
+		&http.Response{
+			Body: io.NopCloser(bytes.NewBufferString(
+				`{"ok":false,"error_code":400,"description":"Bad Request: not enough rights"}`,
+			)),
+		},
+		nil,
+	)
+
+	client := new(Client)
+	client.http = httpClient
+
+	msg, err := client.SendAndPin(context.Background(), 1, "test", DisableNotificationSendOption(true))
+
+	pinErr := new(PinError)
+
+	assert.True(t, errors.As(err, &pinErr))
+	assert.Equal(t, int64(7), pinErr.MessageID)
+	assert.Equal(t, int64(7), msg.MessageID)
+}
@@ -0,0 +1,130 @@
+package tg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+const maxChatCacheEntries = 1024
+
+type chatCacheEntry struct {
+	chatID  int64
+	expires time.Time
+}
+
+// chatCache maps the chat usernames to the chat ids.
+type chatCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]chatCacheEntry
+	now     func() time.Time
+}
+
+func newChatCache(ttl time.Duration) *chatCache {
+	return &chatCache{
+		ttl:     ttl,
+		entries: make(map[string]chatCacheEntry),
+		now:     time.Now,
+	}
+}
+
+func chatCacheKey(username string) string {
+	return strings.ToLower(strings.TrimPrefix(username, "@"))
+}
+
+func (cc *chatCache) get(username string) (int64, bool) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	key := chatCacheKey(username)
+
+	entry, ok := cc.entries[key]
+	if !ok {
+		return 0, false
+	}
+
+	if !cc.now().Before(entry.expires) {
+		delete(cc.entries, key)
+
+		return 0, false
+	}
+
+	return entry.chatID, true
+}
+
+func (cc *chatCache) put(username string, chatID int64) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	now := cc.now()
+
+	if len(cc.entries) >= maxChatCacheEntries {
+		for key, entry := range cc.entries {
+			if !now.Before(entry.expires) {
+				delete(cc.entries, key)
+			}
+		}
+	}
+
+	for len(cc.entries) >= maxChatCacheEntries {
+		var (
+			oldestKey     string
+			oldestExpires time.Time
+		)
+
+		for key, entry := range cc.entries {
+			if oldestExpires.IsZero() || entry.expires.Before(oldestExpires) {
+				oldestKey, oldestExpires = key, entry.expires
+			}
+		}
+
+		delete(cc.entries, oldestKey)
+	}
+
+	cc.entries[chatCacheKey(username)] = chatCacheEntry{chatID: chatID, expires: now.Add(cc.ttl)}
+}
+
+var ErrIncorrectChatCacheTTL = errors.New("incorrect chat cache ttl")
+
+// WithChatCache caches the chat ids of the usernames returned by GetChat
+// for ttl, so ResolveChatID doesn't call getChat every time. The cache is
+// used only by ResolveChatID, the send methods take a numeric chat id.
+func WithChatCache(ttl time.Duration) Option {
+	return func(cl *Client) error {
+		if ttl <= 0 {
+			return ErrIncorrectChatCacheTTL
+		}
+
+		cl.chatCache = newChatCache(ttl)
+
+		return nil
+	}
+}
+
+func (c *Client) cacheChat(chat *Chat) {
+	if c.chatCache != nil && chat.UserName != "" {
+		c.chatCache.put(chat.UserName, chat.ID)
+	}
+}
+
+// ResolveChatID returns the chat id of the public chat @username,
+// using the chat cache (see WithChatCache) if possible. The send methods
+// don't resolve usernames, pass them the id returned by ResolveChatID.
+func (c *Client) ResolveChatID(ctx context.Context, username string) (int64, error) {
+	if c.chatCache != nil {
+		if chatID, ok := c.chatCache.get(username); ok {
+			return chatID, nil
+		}
+	}
+
+	chat, err := c.GetChatByUsername(ctx, username)
+	if err != nil {
+		return 0, fmt.Errorf("ResolveChatID: %w", err)
+	}
+
+	return chat.ID, nil
+}
@@ -0,0 +1,113 @@
+//nolint:exhaustruct
+package tg
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_chatCache(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	cc := newChatCache(time.Minute)
+	cc.now = func() time.Time { return now }
+
+	_, ok := cc.get("@channel")
+	assert.False(t, ok)
+
+	cc.put("Channel", 100)
+
+	chatID, ok := cc.get("@channel")
+	assert.True(t, ok)
+	assert.Equal(t, int64(100), chatID)
+
+	now = now.Add(time.Minute)
+
+	_, ok = cc.get("channel")
+	assert.False(t, ok)
+	assert.Empty(t, cc.entries)
+}
+
+func Test_chatCache_Bounded(t *testing.T) {
+	t.Parallel()
+
+	cc := newChatCache(time.Minute)
+
+	for i := range maxChatCacheEntries + 10 {
+		cc.put("chat"+strconv.Itoa(i), int64(i))
+	}
+
+	assert.Len(t, cc.entries, maxChatCacheEntries)
+}
+
+func Test_Client_ResolveChatID(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.Anything).Return(func(*http.Request) (*http.Response, error) {
+		return &http.Response{
+			Body: io.NopCloser(bytes.NewBufferString(`{"ok":true,"result":{"id":-100,"type":"channel","username":"channel"}}`)),
+		}, nil
+	}).Once()
+
+	client := new(Client)
+	client.http = httpClient
+	client.chatCache = newChatCache(time.Minute)
+
+	ctx := context.Background()
+
+	for range 3 {
+		chatID, err := client.ResolveChatID(ctx, "@channel")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(-100), chatID)
+	}
+}
+
+func Test_GetChat_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		chatID any
+		result error
+	}{
+		{
+			desc:   ErrEmptyChatID.Error(),
+			chatID: int64(0),
+			result: ErrEmptyChatID,
+		},
+		{
+			desc:   ErrIncorrectUsername.Error(),
+			chatID: "@",
+			result: ErrIncorrectUsername,
+		},
+		{
+			desc:   "nil_result_id",
+			chatID: int64(1),
+			result: nil,
+		},
+		{
+			desc:   "nil_result_username",
+			chatID: "@channel",
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, (&GetChat{ChatID: test.chatID}).Validate(), test.result)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package tg
+
+import (
+	"errors"
+	"fmt"
+)
+
+// The chat ids are positive for users and bots and negative for groups,
+// a supergroup or a channel id is -100 followed by the channel id
+// (e.g. -1001234567890), so a small negative id is almost always a bug.
+const maxImplausibleGroupID int64 = 100
+
+var ErrImplausibleChatID = errors.New("implausible chat_id")
+
+// IsPlausibleChatID reports whether chatID looks like a real chat id,
+// 0 and the negative ids from -1 to -100 are not.
+func IsPlausibleChatID(chatID int64) bool {
+	return chatID > 0 || chatID < -maxImplausibleGroupID
+}
+
+// WithStrictChatID makes the messages sent to, edited in and deleted from
+// an implausible chat (see IsPlausibleChatID) fail with ErrImplausibleChatID
+// before hitting the network.
+func WithStrictChatID(strict bool) Option {
+	return func(cl *Client) error {
+		cl.strictChatID = strict
+
+		return nil
+	}
+}
+
+func (c *Client) checkChatID(chatID int64) error {
+	if c.strictChatID && !IsPlausibleChatID(chatID) {
+		return fmt.Errorf("%w: %d", ErrImplausibleChatID, chatID)
+	}
+
+	return nil
+}
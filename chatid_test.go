@@ -0,0 +1,94 @@
+//nolint:exhaustruct
+package tg
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_IsPlausibleChatID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		chatID int64
+		result bool
+	}{
+		{desc: "user", chatID: 123456789, result: true},
+		{desc: "group", chatID: -123456789, result: true},
+		{desc: "supergroup", chatID: -1001234567890, result: true},
+		{desc: "zero", chatID: 0, result: false},
+		{desc: "small_negative", chatID: -5, result: false},
+		{desc: "prefix_only", chatID: -100, result: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.result, IsPlausibleChatID(test.chatID))
+		})
+	}
+}
+
+func Test_Client_StrictChatID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		strict bool
+		chatID int64
+		result error
+	}{
+		{
+			desc:   ErrImplausibleChatID.Error(),
+			strict: true,
+			chatID: -5,
+			result: ErrImplausibleChatID,
+		},
+		{
+			desc:   "not_strict",
+			strict: false,
+			chatID: -5,
+			result: nil,
+		},
+		{
+			desc:   "supergroup",
+			strict: true,
+			chatID: -1001234567890,
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			httpClient := &mockHTTPClient{}
+			httpClient.On("Do", mock.Anything).Return(func(*http.Request) (*http.Response, error) {
+				return &http.Response{
+					Body: io.NopCloser(bytes.NewBufferString(`{"ok":true,"result":{"message_id":1}}`)),
+				}, nil
+			}).Maybe()
+
+			client := new(Client)
+			client.http = httpClient
+
+			assert.NoError(t, WithStrictChatID(test.strict)(client))
+
+			_, err := client.SendMessage(context.Background(), test.chatID, "test")
+
+			assert.ErrorIs(t, err, test.result)
+
+			if test.result != nil {
+				httpClient.AssertNotCalled(t, "Do", mock.Anything)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/a-kataev/tg/cmd/tg/internal/cmd"
+)
+
+var errUnknownShell = errors.New("unknown shell")
+
+func flagNames(flags []cmd.FlagInfo) string {
+	names := make([]string, 0, len(flags))
+
+	for _, ff := range flags {
+		names = append(names, "--"+ff.Name)
+	}
+
+	return strings.Join(names, " ")
+}
+
+func commandNames(cmds []cmd.CommandInfo) string {
+	names := make([]string, 0, len(cmds))
+
+	for _, info := range cmds {
+		names = append(names, info.Name)
+	}
+
+	return strings.Join(names, " ")
+}
+
+func bashCompletion(out io.Writer, app *cmd.Commander) {
+	name := app.Name()
+	fn := "_" + strings.ReplaceAll(name, "-", "_")
+	global := flagNames(app.GlobalFlags())
+	cmds := app.Commands()
+
+	fmt.Fprintf(out, "%s() {\n", fn)
+	fmt.Fprint(out, "  local cur=\"${COMP_WORDS[COMP_CWORD]}\" cmd=\"\" word\n\n")
+	fmt.Fprint(out, "  for word in \"${COMP_WORDS[@]:1:COMP_CWORD-1}\"; do\n")
+	fmt.Fprintf(out, "    case \"$word\" in\n      %s) cmd=\"$word\" ;;\n    esac\n  done\n\n",
+		strings.ReplaceAll(commandNames(cmds), " ", "|"))
+	fmt.Fprint(out, "  case \"$cmd\" in\n")
+
+	for _, info := range cmds {
+		fmt.Fprintf(out, "    %s) COMPREPLY=($(compgen -W \"%s\" -- \"$cur\")) ;;\n",
+			info.Name, strings.TrimSpace(flagNames(info.Flags)+" "+global))
+	}
+
+	fmt.Fprintf(out, "    *) COMPREPLY=($(compgen -W \"%s %s\" -- \"$cur\")) ;;\n", commandNames(cmds), global)
+	fmt.Fprint(out, "  esac\n}\n\n")
+	fmt.Fprintf(out, "complete -o default -F %s %s\n", fn, name)
+}
+
+func zshCompletion(out io.Writer, app *cmd.Commander) {
+	fmt.Fprintf(out, "#compdef %s\n\n", app.Name())
+	fmt.Fprint(out, "autoload -U +X bashcompinit && bashcompinit\n\n")
+
+	bashCompletion(out, app)
+}
+
+func fishCompletion(out io.Writer, app *cmd.Commander) {
+	name := app.Name()
+	cmds := app.Commands()
+
+	fmt.Fprintf(out, "complete -c %s -f\n", name)
+
+	for _, ff := range app.GlobalFlags() {
+		fmt.Fprintf(out, "complete -c %s -l %s -d %q\n", name, ff.Name, ff.Usage)
+	}
+
+	for _, info := range cmds {
+		fmt.Fprintf(out, "complete -c %s -n __fish_use_subcommand -a %s -d %q\n", name, info.Name, info.Description)
+
+		for _, ff := range info.Flags {
+			fmt.Fprintf(out, "complete -c %s -n '__fish_seen_subcommand_from %s' -l %s -d %q\n",
+				name, info.Name, ff.Name, ff.Usage)
+		}
+	}
+}
+
+func completion(out io.Writer, app *cmd.Commander, shell string) error {
+	switch shell {
+	case "bash":
+		bashCompletion(out, app)
+	case "zsh":
+		zshCompletion(out, app)
+	case "fish":
+		fishCompletion(out, app)
+	default:
+		return fmt.Errorf("%w %q, valid shells: bash, zsh or fish", errUnknownShell, shell)
+	}
+
+	return nil
+}
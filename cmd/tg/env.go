@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+var errEnvFileLine = errors.New("expected KEY=VALUE")
+
+// loadEnvFile reads a dotenv-style file (KEY=VALUE lines, # comments,
+// optional export prefix and quotes) into the environment,
+// already set variables are not overridden.
+func loadEnvFile(name string) error {
+	file, err := os.Open(name)
+	if err != nil {
+		return fmt.Errorf("env-file: %w", err)
+	}
+
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	line := 0
+
+	for scanner.Scan() {
+		line++
+
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		text = strings.TrimPrefix(text, "export ")
+
+		key, value, ok := strings.Cut(text, "=")
+		key = strings.TrimSpace(key)
+
+		if !ok || key == "" {
+			return fmt.Errorf("env-file: %s:%d: %w", name, line, errEnvFileLine)
+		}
+
+		value = strings.TrimSpace(value)
+
+		if len(value) > 1 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			if value[0] == '"' {
+				unquoted, err := strconv.Unquote(value)
+				if err != nil {
+					return fmt.Errorf("env-file: %s:%d: %w", name, line, err)
+				}
+
+				value = unquoted
+			} else {
+				value = value[1 : len(value)-1]
+			}
+		}
+
+		if _, exists := os.LookupEnv(key); exists {
+			continue
+		}
+
+		if err := os.Setenv(key, value); err != nil {
+			return fmt.Errorf("env-file: %w", err)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("env-file: %w", err)
+	}
+
+	return nil
+}
@@ -9,7 +9,9 @@ import (
 	"path"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
 type FlagFunc func(*flag.FlagSet)
@@ -21,14 +23,38 @@ func EmptyFlagFunc() func(*flag.FlagSet) {
 type RunFunc func() error
 
 type command struct {
-	name string
-	desc string
-	flag *flag.FlagSet
-	run  RunFunc
+	name     string
+	desc     string
+	flag     *flag.FlagSet
+	run      RunFunc
+	examples []string
+	noArgs   bool
+}
+
+// Command configures a registered command.
+type Command struct {
+	cmd *command
+}
+
+// Examples adds usage examples shown in the command help,
+// an example may span several lines.
+func (c *Command) Examples(examples ...string) *Command {
+	c.cmd.examples = append(c.cmd.examples, examples...)
+
+	return c
+}
+
+// RunWithoutArgs runs the command without arguments instead of showing
+// its help, for the commands whose flags are all optional.
+func (c *Command) RunWithoutArgs() *Command {
+	c.cmd.noArgs = true
+
+	return c
 }
 
 type Commander struct {
 	output io.Writer
+	width  int
 	cmds   map[string]*command
 }
 
@@ -37,6 +63,7 @@ const rootCmd = "_"
 func New() *Commander {
 	cm := new(Commander)
 	cm.output = os.Stderr
+	cm.width = terminalWidth()
 	cm.cmds = make(map[string]*command)
 
 	cm.cmds[rootCmd] = new(command)
@@ -51,6 +78,70 @@ func (c *Commander) SetOutput(out io.Writer) {
 	c.output = out
 }
 
+// SetWidth sets the width the help is wrapped to (default $COLUMNS or 80).
+func (c *Commander) SetWidth(width int) {
+	c.width = width
+}
+
+const (
+	defaultWidth = 80
+	minDescWidth = 20
+)
+
+func terminalWidth() int {
+	if width, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && width > 0 {
+		return width
+	}
+
+	return defaultWidth
+}
+
+// wrap splits text into the lines not longer than width,
+// a word longer than width is kept on its own line.
+func wrap(text string, width int) []string {
+	lines := make([]string, 0, 1)
+	line := ""
+
+	for _, word := range strings.Fields(text) {
+		if line != "" && utf8.RuneCountInString(line)+1+utf8.RuneCountInString(word) > width {
+			lines = append(lines, line)
+			line = ""
+		}
+
+		if line != "" {
+			line += " "
+		}
+
+		line += word
+	}
+
+	return append(lines, line)
+}
+
+// row writes left and text starting at column col, col is limited so the text
+// has at least minDescWidth characters, the text is wrapped to the width with
+// the continuation lines aligned at col, left which doesn't fit is written on
+// its own line.
+func (c *Commander) row(left string, col int, text string) {
+	col = max(min(col, c.width-minDescWidth), 0)
+	indent := strings.Repeat(" ", col)
+	width := utf8.RuneCountInString(left)
+
+	prefix := left + strings.Repeat(" ", max(col-width, 0))
+
+	if width+2 > col {
+		fmt.Fprintln(c.output, left)
+
+		prefix = indent
+	}
+
+	for _, line := range wrap(text, c.width-col) {
+		fmt.Fprintln(c.output, prefix+line)
+
+		prefix = indent
+	}
+}
+
 func defaultValue(value flag.Value, defValue string) string {
 	flagType := reflect.TypeOf(value)
 
@@ -80,23 +171,79 @@ func (c *Commander) usage(fset *flag.FlagSet) {
 	fset.VisitAll(func(ff *flag.Flag) {
 		name, _ := flag.UnquoteUsage(ff)
 
-		strLen := len(name) + len(ff.Name)
+		strLen := utf8.RuneCountInString(name) + utf8.RuneCountInString(ff.Name)
 
 		if strLen > maxLen {
 			maxLen = strLen
 		}
 	})
 
-	maxLen += 4
+	// "  --" + name + " " + type + 4 spaces
+	col := maxLen + 9 //nolint:gomnd
 
 	fset.VisitAll(func(ff *flag.Flag) {
 		name, _ := flag.UnquoteUsage(ff)
 
-		spaces := strings.Repeat(" ", maxLen-(len(name)+len(ff.Name)))
 		def := defaultValue(ff.Value, ff.DefValue)
 
-		fmt.Fprintf(c.output, "  --%s %s%s%s%s\n", ff.Name, name, spaces, ff.Usage, def)
+		c.row(strings.TrimRight(fmt.Sprintf("  --%s %s", ff.Name, name), " "), col, ff.Usage+def)
+	})
+}
+
+// FlagInfo describes a flag for the generated tooling (e.g. shell completion).
+type FlagInfo struct {
+	Name  string
+	Usage string
+}
+
+// CommandInfo describes a command for the generated tooling (e.g. shell completion).
+type CommandInfo struct {
+	Name        string
+	Description string
+	Flags       []FlagInfo
+}
+
+func flagInfos(fset *flag.FlagSet) []FlagInfo {
+	flags := make([]FlagInfo, 0)
+
+	fset.VisitAll(func(ff *flag.Flag) {
+		flags = append(flags, FlagInfo{Name: ff.Name, Usage: ff.Usage})
 	})
+
+	return flags
+}
+
+// Name returns the root command name.
+func (c *Commander) Name() string {
+	return c.cmds[rootCmd].name
+}
+
+// GlobalFlags returns the root flags sorted by name.
+func (c *Commander) GlobalFlags() []FlagInfo {
+	return flagInfos(c.cmds[rootCmd].flag)
+}
+
+// Commands returns the commands and their flags sorted by name.
+func (c *Commander) Commands() []CommandInfo {
+	cmds := make([]CommandInfo, 0, len(c.cmds))
+
+	for name, cmd := range c.cmds {
+		if name == rootCmd {
+			continue
+		}
+
+		cmds = append(cmds, CommandInfo{
+			Name:        name,
+			Description: cmd.desc,
+			Flags:       flagInfos(cmd.flag),
+		})
+	}
+
+	sort.Slice(cmds, func(i, j int) bool {
+		return cmds[i].Name < cmds[j].Name
+	})
+
+	return cmds
 }
 
 func (c *Commander) Root(name string, fn FlagFunc) {
@@ -122,19 +269,18 @@ func (c *Commander) rootHelp() {
 
 			cmds = append(cmds, cmd)
 
-			if len(cmd) > maxCmdLen {
-				maxCmdLen = len(cmd)
+			if utf8.RuneCountInString(cmd) > maxCmdLen {
+				maxCmdLen = utf8.RuneCountInString(cmd)
 			}
 		}
 
 		sort.Strings(cmds)
 
-		maxCmdLen += 4
+		// "  " + command + 4 spaces
+		col := maxCmdLen + 6 //nolint:gomnd
 
 		for _, cmd := range cmds {
-			spaces := strings.Repeat(" ", maxCmdLen-len(cmd))
-
-			fmt.Fprintf(c.output, "  %s%s%s\n", cmd, spaces, c.cmds[cmd].desc)
+			c.row("  "+cmd, col, c.cmds[cmd].desc)
 		}
 	} else {
 		fmt.Fprint(c.output, "\n")
@@ -149,7 +295,7 @@ func (c *Commander) rootError(err error) {
 	fmt.Fprintf(c.output, "Error: %s\n\nRun '%s --help' for usage.\n", err, c.cmds[rootCmd].name)
 }
 
-func (c *Commander) Command(name, desc string, fn FlagFunc, runFn RunFunc) {
+func (c *Commander) Command(name, desc string, fn FlagFunc, runFn RunFunc) *Command {
 	fset := flag.NewFlagSet(name, flag.ContinueOnError)
 	fset.SetOutput(io.Discard)
 
@@ -161,6 +307,8 @@ func (c *Commander) Command(name, desc string, fn FlagFunc, runFn RunFunc) {
 		flag: fset,
 		run:  runFn,
 	}
+
+	return &Command{cmd: c.cmds[name]}
 }
 
 func (c *Commander) commandHelp(name string) {
@@ -195,10 +343,30 @@ func (c *Commander) commandHelp(name string) {
 	fmt.Fprint(c.output, "\nGlobal Flags:\n")
 
 	c.usage(c.cmds[rootCmd].flag)
+
+	c.examples(c.cmds[name].examples)
+}
+
+func (c *Commander) examples(examples []string) {
+	if len(examples) == 0 {
+		return
+	}
+
+	fmt.Fprint(c.output, "\nExamples:\n")
+
+	for i, example := range examples {
+		if i > 0 {
+			fmt.Fprint(c.output, "\n")
+		}
+
+		for _, line := range strings.Split(example, "\n") {
+			fmt.Fprintf(c.output, "  %s\n", line)
+		}
+	}
 }
 
 func (c *Commander) commandError(name string, err error) {
-	fmt.Fprintf(c.output, "Error: %s\n\nRun '%s %s--help' for usage.\n", err, c.cmds[rootCmd].name, name)
+	fmt.Fprintf(c.output, "Error: %s\n\nRun '%s %s --help' for usage.\n", err, c.cmds[rootCmd].name, name)
 }
 
 func (c *Commander) Run() { //nolint:cyclop
@@ -230,7 +398,7 @@ func (c *Commander) Run() { //nolint:cyclop
 		os.Exit(1)
 	}
 
-	if len(args) == 0 {
+	if len(args) == 0 && !cmd.noArgs {
 		flags := false
 
 		c.cmds[name].flag.VisitAll(func(_ *flag.Flag) {
@@ -0,0 +1,78 @@
+//nolint:exhaustruct
+package cmd
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Commander_rootHelp(t *testing.T) {
+	t.Parallel()
+
+	out := new(bytes.Buffer)
+
+	commander := New()
+	commander.SetOutput(out)
+	commander.SetWidth(80)
+	commander.Root("tg", func(fset *flag.FlagSet) {
+		fset.String("token", "", "bot token")
+		fset.String("язык", "", "language")
+	})
+	commander.Command("send", "send message", EmptyFlagFunc(), nil)
+	commander.Command("отправить", "send message", EmptyFlagFunc(), nil)
+
+	commander.rootHelp()
+
+	assert.Equal(t, "Usage:\n"+
+		"  tg [flags] [command]\n"+
+		"\n"+
+		"Available Commands:\n"+
+		"  send         send message\n"+
+		"  отправить    send message\n"+
+		"\n"+
+		"Flags:\n"+
+		"  --token string    bot token\n"+
+		"  --язык string     language\n",
+		out.String())
+}
+
+func Test_wrap(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		text   string
+		width  int
+		result []string
+	}{
+		{
+			desc:   "short",
+			text:   "send message",
+			width:  20,
+			result: []string{"send message"},
+		},
+		{
+			desc:   "wrapped",
+			text:   "отправить сообщение в чат",
+			width:  20,
+			result: []string{"отправить сообщение", "в чат"},
+		},
+		{
+			desc:   "long_word",
+			text:   "a verylongword b",
+			width:  5,
+			result: []string{"a", "verylongword", "b"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.result, wrap(test.text, test.width))
+		})
+	}
+}
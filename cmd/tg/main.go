@@ -3,18 +3,32 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"flag"
+	"fmt"
 	"io"
 	"log/slog"
 	"os"
+	"strconv"
+	"time"
+	"unicode/utf8"
 
 	"github.com/a-kataev/tg"
 	"github.com/a-kataev/tg/cmd/tg/internal/cmd"
 )
 
 type flags struct {
+	sets                  map[string]*flag.FlagSet
+	envFile               string
 	token                 string
+	apiServer             string
+	retries               int
+	maxRetryAfter         time.Duration
+	timeout               time.Duration
+	verbose               bool
+	quiet                 bool
+	logLevel              *slog.LevelVar
 	chatID                int64
 	text                  string
 	parseMode             string
@@ -23,6 +37,40 @@ type flags struct {
 	disableWebPagePreview bool
 	disableNotification   bool
 	protectContent        bool
+	pin                   bool
+	pinSilent             bool
+	typing                bool
+	json                  bool
+}
+
+func (f *flags) addSet(fset *flag.FlagSet) {
+	if f.sets == nil {
+		f.sets = make(map[string]*flag.FlagSet)
+	}
+
+	f.sets[fset.Name()] = fset
+}
+
+func (f *flags) isSet(cmd, name string) bool {
+	set := false
+
+	if fset, ok := f.sets[cmd]; ok {
+		fset.Visit(func(ff *flag.Flag) {
+			if ff.Name == name {
+				set = true
+			}
+		})
+	}
+
+	return set
+}
+
+func (f *flags) loadEnvFile() error {
+	if f.envFile == "" {
+		return nil
+	}
+
+	return loadEnvFile(f.envFile)
 }
 
 func (f *flags) tokenFormEnv() {
@@ -31,54 +79,272 @@ func (f *flags) tokenFormEnv() {
 	}
 }
 
+func (f *flags) apiServerFromEnv() {
+	if f.apiServer == "" {
+		f.apiServer = os.Getenv("TG_API_SERVER")
+	}
+}
+
+func (f *flags) chatIDFromEnv() error {
+	if f.chatID != 0 {
+		return nil
+	}
+
+	env := os.Getenv("TG_CHAT_ID")
+	if env == "" {
+		return nil
+	}
+
+	chatID, err := strconv.ParseInt(env, 10, 64)
+	if err != nil {
+		return fmt.Errorf("TG_CHAT_ID: %w", err)
+	}
+
+	f.chatID = chatID
+
+	return nil
+}
+
+func (f *flags) parseModeFromEnv(cmd string) {
+	if f.isSet(cmd, "parse-mode") {
+		return
+	}
+
+	if env, ok := os.LookupEnv("TG_PARSE_MODE"); ok {
+		f.parseMode = env
+	}
+}
+
+func (f *flags) fromEnv(cmd string) error {
+	if err := f.loadEnvFile(); err != nil {
+		return err
+	}
+
+	f.tokenFormEnv()
+	f.apiServerFromEnv()
+
+	if err := f.chatIDFromEnv(); err != nil {
+		return err
+	}
+
+	f.parseModeFromEnv(cmd)
+
+	return nil
+}
+
+// maxStdinSize bounds the stdin read, a UTF-16 code unit takes up to 3 bytes
+// in UTF-8 (a 4 byte rune takes 2 units), so it always holds MaxTextSize units
+// of a valid text.
+const maxStdinSize = 3 * int64(tg.MaxTextSize)
+
+var errInvalidUTF8 = errors.New("text is not valid UTF-8")
+
+// trimIncompleteRune drops the bytes of the last rune cut by the read limit.
+func trimIncompleteRune(data []byte) []byte {
+	for i := 1; i <= utf8.UTFMax && i <= len(data); i++ {
+		if utf8.RuneStart(data[len(data)-i]) {
+			if !utf8.FullRune(data[len(data)-i:]) {
+				return data[:len(data)-i]
+			}
+
+			break
+		}
+	}
+
+	return data
+}
+
 func (f *flags) textFromPipe() error {
 	if f.text == "-" {
-		stdin, err := io.ReadAll(io.LimitReader(os.Stdin, int64(tg.MaxTextSize)))
+		stdin, err := io.ReadAll(io.LimitReader(os.Stdin, maxStdinSize))
 		if err != nil {
 			if !errors.Is(err, io.EOF) {
 				return err
 			}
 		}
 
-		f.text = string(stdin)
+		if int64(len(stdin)) == maxStdinSize {
+			stdin = trimIncompleteRune(stdin)
+		}
+
+		if !utf8.Valid(stdin) {
+			return errInvalidUTF8
+		}
+
+		f.text = tg.TruncateText(string(stdin), tg.MaxTextSize)
 	}
 
 	return nil
 }
 
+const autoParseMode = "auto"
+
+func (f *flags) validateParseMode() error {
+	if f.parseMode == autoParseMode {
+		return nil
+	}
+
+	if err := tg.ParseMode(f.parseMode).Validate(); err != nil {
+		return fmt.Errorf("%w %q, valid modes: %s, %s, %s, %s or empty", err, f.parseMode,
+			tg.MarkdownV2ParseMode, tg.MarkdownParseMode, tg.HTMLParseMode, autoParseMode)
+	}
+
+	return nil
+}
+
+// textParseMode returns the parse mode detected in the text for "auto".
+func (f *flags) textParseMode() tg.ParseMode {
+	if f.parseMode == autoParseMode {
+		return tg.DetectParseMode(f.text)
+	}
+
+	return tg.ParseMode(f.parseMode)
+}
+
+func (f *flags) newClient(log *slog.Logger) (*tg.Client, error) {
+	opts := make([]tg.Option, 0, 3) //nolint:gomnd
+
+	if f.verbose {
+		opts = append(opts, tg.WithLogger(log))
+	}
+
+	if f.retries != 0 {
+		opts = append(opts, tg.WithRetry(f.retries, f.maxRetryAfter))
+	}
+
+	if f.apiServer != "" {
+		// validate before building the client to point at the flag
+		if err := tg.WithAPIServer(f.apiServer)(new(tg.Client)); err != nil {
+			return nil, fmt.Errorf("api-server %q: %w", f.apiServer, err)
+		}
+
+		opts = append(opts, tg.WithAPIServer(f.apiServer))
+	}
+
+	client, err := tg.NewClient(f.token, opts...)
+	if errors.Is(err, tg.ErrIncorrectRetry) {
+		return nil, fmt.Errorf("retries: %w", err)
+	}
+
+	return client, err
+}
+
+var (
+	errRequestTimedOut = errors.New("request timed out")
+	errChatNotFound    = errors.New("chat not found — check --chat-id and that the bot is a member/admin")
+)
+
+// friendlyError replaces the well-known API errors with a hint,
+// the raw error is appended with --verbose.
+func (f *flags) friendlyError(err error) error {
+	var hint error
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		hint = errRequestTimedOut
+	case tg.IsChatNotFound(err):
+		hint = errChatNotFound
+	default:
+		return err
+	}
+
+	if f.verbose {
+		return fmt.Errorf("%w: %w", hint, err)
+	}
+
+	return hint
+}
+
+// printResult prints the bare result (message id or true) to stdout with --quiet.
+func (f *flags) printResult(result any) {
+	if f.quiet {
+		fmt.Fprintln(os.Stdout, result)
+	}
+}
+
+// withTimeout runs the command with a context limited by --timeout.
+func (f *flags) withTimeout(ctx context.Context, run func(context.Context) error) func() error {
+	return func() error {
+		switch {
+		case f.verbose:
+			f.logLevel.Set(slog.LevelDebug)
+		case f.quiet:
+			f.logLevel.Set(slog.LevelWarn)
+		}
+
+		if f.timeout > 0 {
+			var cancel context.CancelFunc
+
+			ctx, cancel = context.WithTimeout(ctx, f.timeout)
+			defer cancel()
+		}
+
+		if err := run(ctx); err != nil {
+			return f.friendlyError(err)
+		}
+
+		return nil
+	}
+}
+
 func (f *flags) rootFlags() func(*flag.FlagSet) {
 	return func(fset *flag.FlagSet) {
-		fset.StringVar(&f.token, "token", "", "bot token")
+		fset.StringVar(&f.token, "token", "", "bot token (environment TG_TOKEN)")
+		fset.StringVar(&f.apiServer, "api-server", "", "bot api server url (environment TG_API_SERVER)")
+		fset.DurationVar(&f.timeout, "timeout", 30*time.Second, //nolint:gomnd
+			"command timeout, including retries (0 no limit)")
+		fset.IntVar(&f.retries, "retries", 0, "retry rate limited (429) requests up to N times")
+		fset.DurationVar(&f.maxRetryAfter, "max-retry-after", 30*time.Second, //nolint:gomnd
+			"give up on a rate limited request asked to wait longer (0 no limit)")
+		fset.BoolVar(&f.verbose, "verbose", false, "debug logging of requests and raw errors")
+		fset.BoolVar(&f.quiet, "quiet", false, "print only the message id (true for delete) instead of the log")
+		fset.BoolVar(&f.quiet, "q", false, "shorthand for --quiet")
+		fset.StringVar(&f.envFile, "env-file", "", "load TG_* environment variables from file")
 	}
 }
 
 func (f *flags) sendFlags() func(*flag.FlagSet) {
 	return func(fset *flag.FlagSet) {
-		fset.Int64Var(&f.chatID, "chat-id", 0, "chat id")
+		f.addSet(fset)
+		fset.Int64Var(&f.chatID, "chat-id", 0, "chat id (environment TG_CHAT_ID)")
 		fset.StringVar(&f.text, "text", "", "text (use - for read pipe)")
-		fset.StringVar(&f.parseMode, "parse-mode", "Markdown", "parse mode")
+		fset.StringVar(&f.parseMode, "parse-mode", "Markdown",
+			"parse mode, auto detects it in the text (environment TG_PARSE_MODE)")
 		fset.Int64Var(&f.messageThreadID, "message-thread-id", 0, "message thread id")
 		fset.BoolVar(&f.disableWebPagePreview, "disable-web-page-preview", false, "disable web page preview")
 		fset.BoolVar(&f.disableNotification, "disable-notification", false, "disable notification")
 		fset.BoolVar(&f.protectContent, "protect-content", false, "protect content")
+		fset.BoolVar(&f.pin, "pin", false, "pin message after send")
+		fset.BoolVar(&f.pinSilent, "pin-silent", false, "pin message without notification")
+		fset.BoolVar(&f.typing, "typing", false, "show typing in the chat before send")
 	}
 }
 
-func (f *flags) sendRun(ctx context.Context, log *slog.Logger) func() error {
-	return func() error {
-		f.tokenFormEnv()
+func (f *flags) sendRun(log *slog.Logger) func(context.Context) error {
+	return func(ctx context.Context) error {
+		if err := f.fromEnv("send"); err != nil {
+			return err
+		}
+
+		if err := f.validateParseMode(); err != nil {
+			return err
+		}
 
 		if err := f.textFromPipe(); err != nil {
 			return err
 		}
 
-		client, err := tg.NewClient(f.token)
+		client, err := f.newClient(log)
 		if err != nil {
 			return err
 		}
 
+		if f.typing {
+			f.sendTyping(ctx, client, log)
+		}
+
 		msg, err := client.SendMessage(ctx, f.chatID, f.text,
-			tg.ParseModeSendOption(tg.ParseMode(f.parseMode)),
+			tg.ParseModeSendOption(f.textParseMode()),
 			tg.MessageThreadIDSendOption(f.messageThreadID),
 			tg.DisableWebPagePreviewSendOption(f.disableWebPagePreview),
 			tg.DisableNotificationSendOption(f.disableNotification),
@@ -93,34 +359,75 @@ func (f *flags) sendRun(ctx context.Context, log *slog.Logger) func() error {
 			slog.Any("message_id", msg.MessageID),
 		)
 
+		f.printResult(msg.MessageID)
+
+		if !f.pin && !f.pinSilent {
+			return nil
+		}
+
+		_, err = client.PinChatMessage(ctx, f.chatID, msg.MessageID,
+			tg.DisableNotificationPinOption(f.pinSilent),
+		)
+		if err != nil {
+			return err
+		}
+
+		log.Info("Success pin message",
+			slog.Int64("chat_id", f.chatID),
+			slog.Any("message_id", msg.MessageID),
+		)
+
 		return nil
 	}
 }
 
+// sendTyping shows the typing action in the chat, the send doesn't depend
+// on it, so a failure is only logged (and dropped with --quiet, which
+// keeps stdout for the result).
+func (f *flags) sendTyping(ctx context.Context, client *tg.Client, log *slog.Logger) {
+	_, err := client.SendChatAction(ctx, f.chatID, tg.TypingChatAction,
+		tg.MessageThreadIDChatActionOption(f.messageThreadID),
+	)
+	if err != nil && !f.quiet {
+		log.Warn("Failed send chat action",
+			slog.Int64("chat_id", f.chatID),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
 func (f *flags) editFlags() func(*flag.FlagSet) {
 	return func(fset *flag.FlagSet) {
-		fset.Int64Var(&f.chatID, "chat-id", 0, "chat id")
+		f.addSet(fset)
+		fset.Int64Var(&f.chatID, "chat-id", 0, "chat id (environment TG_CHAT_ID)")
 		fset.StringVar(&f.text, "text", "", "text (use - for read pipe)")
-		fset.StringVar(&f.parseMode, "parse-mode", "Markdown", "parse mode")
+		fset.StringVar(&f.parseMode, "parse-mode", "Markdown",
+			"parse mode, auto detects it in the text (environment TG_PARSE_MODE)")
 		fset.Int64Var(&f.messageID, "message-id", 0, "message id")
 	}
 }
 
-func (f *flags) editRun(ctx context.Context, log *slog.Logger) func() error {
-	return func() error {
-		f.tokenFormEnv()
+func (f *flags) editRun(log *slog.Logger) func(context.Context) error {
+	return func(ctx context.Context) error {
+		if err := f.fromEnv("edit"); err != nil {
+			return err
+		}
+
+		if err := f.validateParseMode(); err != nil {
+			return err
+		}
 
 		if err := f.textFromPipe(); err != nil {
 			return err
 		}
 
-		client, err := tg.NewClient(f.token)
+		client, err := f.newClient(log)
 		if err != nil {
 			return err
 		}
 
 		msg, err := client.EditMessage(ctx, f.chatID, f.messageID, f.text,
-			tg.ParseModeEditOption(tg.ParseMode(f.parseMode)),
+			tg.ParseModeEditOption(f.textParseMode()),
 		)
 		if err != nil {
 			return err
@@ -131,27 +438,32 @@ func (f *flags) editRun(ctx context.Context, log *slog.Logger) func() error {
 			slog.Any("message_id", msg.MessageID),
 		)
 
+		f.printResult(msg.MessageID)
+
 		return nil
 	}
 }
 
 func (f *flags) deleteFlags() func(*flag.FlagSet) {
 	return func(fset *flag.FlagSet) {
-		fset.Int64Var(&f.chatID, "chat-id", 0, "chat id")
+		f.addSet(fset)
+		fset.Int64Var(&f.chatID, "chat-id", 0, "chat id (environment TG_CHAT_ID)")
 		fset.Int64Var(&f.messageID, "message-id", 0, "message id")
 	}
 }
 
-func (f *flags) deleteRun(ctx context.Context, log *slog.Logger) func() error {
-	return func() error {
-		f.tokenFormEnv()
+func (f *flags) deleteRun(log *slog.Logger) func(context.Context) error {
+	return func(ctx context.Context) error {
+		if err := f.fromEnv("delete"); err != nil {
+			return err
+		}
 
-		client, err := tg.NewClient(f.token)
+		client, err := f.newClient(log)
 		if err != nil {
 			return err
 		}
 
-		_, err = client.DeleteMessage(ctx, f.chatID, f.messageID)
+		deleted, err := client.DeleteMessage(ctx, f.chatID, f.messageID)
 		if err != nil {
 			return err
 		}
@@ -161,22 +473,90 @@ func (f *flags) deleteRun(ctx context.Context, log *slog.Logger) func() error {
 			slog.Any("message_id", f.messageID),
 		)
 
+		f.printResult(deleted)
+
+		return nil
+	}
+}
+
+func (f *flags) whoamiFlags() func(*flag.FlagSet) {
+	return func(fset *flag.FlagSet) {
+		f.addSet(fset)
+		fset.BoolVar(&f.json, "json", false, "print the bot as json")
+	}
+}
+
+func (f *flags) whoamiRun(log *slog.Logger) func(context.Context) error {
+	return func(ctx context.Context) error {
+		if err := f.fromEnv("whoami"); err != nil {
+			return err
+		}
+
+		client, err := f.newClient(log)
+		if err != nil {
+			return err
+		}
+
+		me, err := client.GetMe(ctx)
+		if err != nil {
+			return err
+		}
+
+		if f.json {
+			return json.NewEncoder(os.Stdout).Encode(me)
+		}
+
+		fmt.Fprintf(os.Stdout, "id: %d\nusername: %s\nfirst_name: %s\n", me.ID, me.UserName, me.FirstName)
+
 		return nil
 	}
 }
 
+func (f *flags) completionFlags() func(*flag.FlagSet) {
+	return func(fset *flag.FlagSet) {
+		f.addSet(fset)
+	}
+}
+
+func (f *flags) completionRun(app *cmd.Commander) func() error {
+	return func() error {
+		return completion(os.Stdout, app, f.sets["completion"].Arg(0))
+	}
+}
+
 func main() {
 	ctx := context.Background()
-	log := slog.New(slog.NewJSONHandler(os.Stdout, nil))
-
-	app := cmd.New()
 
 	flags := new(flags)
+	flags.logLevel = new(slog.LevelVar)
+
+	log := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: flags.logLevel}))
+
+	app := cmd.New()
 
 	app.Root("tg", flags.rootFlags())
-	app.Command("send", "send message", flags.sendFlags(), flags.sendRun(ctx, log))
-	app.Command("edit", "edit message", flags.editFlags(), flags.editRun(ctx, log))
-	app.Command("delete", "delete message", flags.deleteFlags(), flags.deleteRun(ctx, log))
+	app.Command("send", "send message", flags.sendFlags(), flags.withTimeout(ctx, flags.sendRun(log))).Examples(
+		"# send text from stdin\n"+
+			"echo 'build *passed*' | tg send --chat-id 123 --text -",
+		"# send to a forum topic\n"+
+			"tg send --chat-id -100123 --message-thread-id 42 --text 'hello'",
+	)
+	app.Command("edit", "edit message", flags.editFlags(), flags.withTimeout(ctx, flags.editRun(log))).Examples(
+		"# send a message and edit it later\n" +
+			"id=$(tg -q send --chat-id 123 --text 'deploying…')\n" +
+			"tg edit --chat-id 123 --message-id \"$id\" --text 'deployed'",
+	)
+	app.Command("delete", "delete message", flags.deleteFlags(), flags.withTimeout(ctx, flags.deleteRun(log)))
+	app.Command("whoami", "print the bot id, username and first name",
+		flags.whoamiFlags(), flags.withTimeout(ctx, flags.whoamiRun(log))).RunWithoutArgs().Examples(
+		"# check the token\n" +
+			"tg --token \"$TG_TOKEN\" whoami",
+	)
+	app.Command("completion", "generate shell completion script (bash, zsh or fish)",
+		flags.completionFlags(), flags.completionRun(app)).Examples(
+		"# load completion in the current bash session\n" +
+			"source <(tg completion bash)",
+	)
 
 	app.Run()
 }
@@ -0,0 +1,69 @@
+//nolint:exhaustruct
+package tg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Client_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.Anything).Return(func(req *http.Request) (*http.Response, error) {
+		sm := new(SendMessage)
+
+		if err := json.NewDecoder(req.Body).Decode(sm); err != nil {
+			return nil, err
+		}
+
+		body, err := json.Marshal(map[string]any{
+			"ok":     true,
+			"result": Message{MessageID: sm.ChatID, Chat: Chat{ID: sm.ChatID}, Text: sm.Text},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return &http.Response{Body: io.NopCloser(bytes.NewBuffer(body))}, nil
+	})
+
+	client := new(Client)
+	client.http = httpClient
+	client.dedup = newDedup(time.Minute)
+	client.chatLimiter = newChatLimiter(1000, 1000)
+
+	const calls = 64
+
+	ctx := context.Background()
+	wg := sync.WaitGroup{}
+
+	for i := range calls {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			chatID := int64(i%8 + 1)
+			text := fmt.Sprintf("test %d", i)
+
+			msg, err := client.SendMessage(ctx, chatID, text)
+
+			assert.NoError(t, err)
+			assert.Equal(t, chatID, msg.Chat.ID)
+			assert.Equal(t, text, msg.Text)
+		}()
+	}
+
+	wg.Wait()
+}
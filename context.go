@@ -0,0 +1,137 @@
+package tg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrBaseContextNil         = errors.New("base context is nil")
+	ErrIncorrectMethodTimeout = errors.New("incorrect method timeout")
+)
+
+// WithBaseContext bounds every API call by ctx: a call ends when either its own
+// context or ctx is done, so a deadline set on ctx (e.g. the whole CLI run)
+// applies even to the calls made with context.Background().
+func WithBaseContext(ctx context.Context) Option {
+	return func(cl *Client) error {
+		if ctx == nil {
+			return ErrBaseContextNil
+		}
+
+		cl.baseCtx = ctx
+
+		return nil
+	}
+}
+
+// WithMethodTimeout bounds every method call, including its retries, by d
+// if the context passed to the method has no deadline, so the calls made with
+// context.Background() end even with an HTTPClient without a timeout.
+func WithMethodTimeout(d time.Duration) Option {
+	return func(cl *Client) error {
+		if d <= 0 {
+			return ErrIncorrectMethodTimeout
+		}
+
+		cl.methodTimeout = d
+
+		return nil
+	}
+}
+
+// WithMethodTimeouts bounds the calls of the listed methods (e.g. "getMe",
+// "sendMediaGroup") by their own timeout, it's applied even if the context
+// passed to the method has an (only later) deadline, the other methods fall
+// back to WithMethodTimeout. The options add up, a method set again is replaced.
+func WithMethodTimeouts(timeouts map[string]time.Duration) Option {
+	return func(cl *Client) error {
+		for method, d := range timeouts {
+			if method == "" {
+				return fmt.Errorf("methodtimeouts: %w", ErrEmptyMethod)
+			}
+
+			if d <= 0 {
+				return fmt.Errorf("methodtimeouts: %s: %w", method, ErrIncorrectMethodTimeout)
+			}
+		}
+
+		if cl.methodTimeouts == nil {
+			cl.methodTimeouts = make(map[string]time.Duration, len(timeouts))
+		}
+
+		for method, d := range timeouts {
+			cl.methodTimeouts[method] = d
+		}
+
+		return nil
+	}
+}
+
+func (c *Client) withMethodTimeout(ctx context.Context, method string) (context.Context, context.CancelFunc) {
+	if d, ok := c.methodTimeouts[method]; ok {
+		return context.WithTimeout(ctx, d)
+	}
+
+	if c.methodTimeout == 0 {
+		return ctx, func() {}
+	}
+
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+
+	return context.WithTimeout(ctx, c.methodTimeout)
+}
+
+// WithTimeoutContext returns a context derived from parent that is done after d
+// or when the client base context is done, whichever is first.
+//
+// The recommended pattern for the calls without a deadline of their own:
+//
+//	ctx, cancel := client.WithTimeoutContext(ctx, 10*time.Second)
+//	defer cancel()
+//
+//	msg, err := client.SendMessage(ctx, chatID, text)
+func (c *Client) WithTimeoutContext(parent context.Context, d time.Duration) (context.Context, context.CancelFunc) {
+	ctx, cancel := c.withBaseContext(parent)
+	ctx, cancelTimeout := context.WithTimeout(ctx, d)
+
+	return ctx, func() {
+		cancelTimeout()
+		cancel()
+	}
+}
+
+func (c *Client) withBaseContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.baseCtx == nil {
+		return ctx, func() {}
+	}
+
+	cancels := make([]context.CancelFunc, 0, 2) //nolint:gomnd
+
+	if deadline, ok := c.baseCtx.Deadline(); ok {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithDeadline(ctx, deadline)
+		cancels = append(cancels, cancel)
+	}
+
+	ctx, cancelCause := context.WithCancelCause(ctx)
+	stop := context.AfterFunc(c.baseCtx, func() {
+		cancelCause(context.Cause(c.baseCtx))
+	})
+
+	cancels = append(cancels, func() {
+		stop()
+		cancelCause(context.Canceled)
+	})
+
+	return ctx, func() {
+		for i := len(cancels) - 1; i >= 0; i-- {
+			cancels[i]()
+		}
+	}
+}
@@ -0,0 +1,192 @@
+//nolint:exhaustruct
+package tg
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Client_API_BaseContext(t *testing.T) {
+	t.Parallel()
+
+	baseCtx, cancel := context.WithCancel(context.Background())
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.Anything).Return(func(req *http.Request) (*http.Response, error) {
+		<-req.Context().Done()
+
+		return nil, req.Context().Err()
+	})
+
+	client := new(Client)
+	client.http = httpClient
+
+	assert.NoError(t, WithBaseContext(baseCtx)(client))
+
+	go cancel()
+
+	err := client.API(context.Background(), getMeMethod, nil, new(User))
+
+	assert.Equal(t, fmt.Errorf("request: %w", context.Canceled), err)
+}
+
+func Test_Client_WithTimeoutContext(t *testing.T) {
+	t.Parallel()
+
+	deadline := time.Now().Add(time.Minute)
+
+	baseCtx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	client := new(Client)
+
+	assert.Equal(t, ErrBaseContextNil, WithBaseContext(nil)(client)) //nolint:staticcheck
+	assert.NoError(t, WithBaseContext(baseCtx)(client))
+
+	ctx, cancelTimeout := client.WithTimeoutContext(context.Background(), time.Hour)
+
+	result, ok := ctx.Deadline()
+	assert.True(t, ok)
+	assert.Equal(t, deadline, result)
+
+	cancelTimeout()
+
+	assert.ErrorIs(t, ctx.Err(), context.Canceled)
+}
+
+func Test_Client_API_MethodTimeout(t *testing.T) {
+	t.Parallel()
+
+	deadline := time.Now().Add(time.Hour)
+
+	ctxWithDeadline, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	tests := []struct {
+		desc   string
+		ctx    context.Context //nolint:containedctx
+		result func(time.Time) bool
+	}{
+		{
+			desc: "no_deadline",
+			ctx:  context.Background(),
+			result: func(result time.Time) bool {
+				return time.Until(result) <= time.Minute
+			},
+		},
+		{
+			desc: "deadline",
+			ctx:  ctxWithDeadline,
+			result: func(result time.Time) bool {
+				return result.Equal(deadline)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			httpClient := newMockHTTPClient(t)
+			httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+				result, ok := req.Context().Deadline()
+
+				return ok && test.result(result)
+			})).Return(func(*http.Request) (*http.Response, error) {
+				return &http.Response{
+					Body: io.NopCloser(bytes.NewBufferString(`{"ok":true,"result":{"id":1}}`)),
+				}, nil
+			})
+
+			client := new(Client)
+			client.http = httpClient
+
+			assert.Equal(t, ErrIncorrectMethodTimeout, WithMethodTimeout(0)(client))
+			assert.NoError(t, WithMethodTimeout(time.Minute)(client))
+
+			_, err := client.GetMe(test.ctx)
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_Client_API_MethodTimeouts(t *testing.T) {
+	t.Parallel()
+
+	deadline := time.Now().Add(time.Hour)
+
+	ctxWithDeadline, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	tests := []struct {
+		desc   string
+		method string
+		ctx    context.Context //nolint:containedctx
+		result func(time.Time) bool
+	}{
+		{
+			desc:   "method",
+			method: getMeMethod,
+			ctx:    ctxWithDeadline,
+			result: func(result time.Time) bool {
+				return time.Until(result) <= time.Second
+			},
+		},
+		{
+			desc:   "fallback",
+			method: getChatMethod,
+			ctx:    context.Background(),
+			result: func(result time.Time) bool {
+				return time.Until(result) > time.Second && time.Until(result) <= time.Minute
+			},
+		},
+		{
+			desc:   "fallback_deadline",
+			method: getChatMethod,
+			ctx:    ctxWithDeadline,
+			result: func(result time.Time) bool {
+				return result.Equal(deadline)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			httpClient := newMockHTTPClient(t)
+			httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+				result, ok := req.Context().Deadline()
+
+				return ok && test.result(result)
+			})).Return(func(*http.Request) (*http.Response, error) {
+				return &http.Response{
+					Body: io.NopCloser(bytes.NewBufferString(`{"ok":true,"result":{"id":1}}`)),
+				}, nil
+			})
+
+			client := new(Client)
+			client.http = httpClient
+
+			assert.Equal(t, fmt.Errorf("methodtimeouts: %w", ErrEmptyMethod),
+				WithMethodTimeouts(map[string]time.Duration{"": time.Second})(client))
+			assert.Equal(t, fmt.Errorf("methodtimeouts: getMe: %w", ErrIncorrectMethodTimeout),
+				WithMethodTimeouts(map[string]time.Duration{getMeMethod: 0})(client))
+			assert.NoError(t, WithMethodTimeouts(map[string]time.Duration{getMeMethod: time.Second})(client))
+			assert.NoError(t, WithMethodTimeout(time.Minute)(client))
+
+			err := client.API(test.ctx, test.method, nil, new(User))
+
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package tg
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+)
+
+const maxDedupEntries = 1024
+
+type dedupKey [sha256.Size]byte
+
+type dedupEntry struct {
+	msg  *Message
+	sent time.Time
+}
+
+// dedup remembers the recently sent messages to suppress identical sends.
+type dedup struct {
+	mu      sync.Mutex
+	window  time.Duration
+	entries map[dedupKey]dedupEntry
+	now     func() time.Time
+}
+
+func newDedup(window time.Duration) *dedup {
+	return &dedup{
+		window:  window,
+		entries: make(map[dedupKey]dedupEntry),
+		now:     time.Now,
+	}
+}
+
+func newDedupKey(sm *SendMessage) dedupKey {
+	hash := sha256.New()
+
+	_ = binary.Write(hash, binary.LittleEndian, sm.ChatID)
+	_ = binary.Write(hash, binary.LittleEndian, sm.MessageThreadID)
+
+	// the markup and the entities change the sent message as much as the text
+	markup, _ := json.Marshal(sm.ReplyMarkup) //nolint:errchkjson
+	entities, _ := json.Marshal(sm.Entities)  //nolint:errchkjson
+
+	for _, field := range [][]byte{[]byte(sm.ParseMode), markup, entities} {
+		_ = binary.Write(hash, binary.LittleEndian, int64(len(field)))
+
+		hash.Write(field)
+	}
+
+	hash.Write([]byte(sm.Text))
+
+	var key dedupKey
+
+	copy(key[:], hash.Sum(nil))
+
+	return key
+}
+
+// evict removes the expired entries, and the oldest ones if the limit is reached.
+// Must be called with mu held.
+func (d *dedup) evict(now time.Time) {
+	for key, entry := range d.entries {
+		if now.Sub(entry.sent) >= d.window {
+			delete(d.entries, key)
+		}
+	}
+
+	for len(d.entries) >= maxDedupEntries {
+		var (
+			oldestKey  dedupKey
+			oldestSent time.Time
+		)
+
+		for key, entry := range d.entries {
+			if oldestSent.IsZero() || entry.sent.Before(oldestSent) {
+				oldestKey, oldestSent = key, entry.sent
+			}
+		}
+
+		delete(d.entries, oldestKey)
+	}
+}
+
+// copyMessage copies the fields of the text message sent by SendMessage,
+// so a caller changing the message doesn't change the cached one.
+func copyMessage(msg *Message) *Message {
+	cp := *msg
+
+	if msg.From != nil {
+		from := *msg.From
+		cp.From = &from
+	}
+
+	cp.Entities = slices.Clone(msg.Entities)
+
+	for i, entity := range cp.Entities {
+		if entity.User != nil {
+			user := *entity.User
+			cp.Entities[i].User = &user
+		}
+	}
+
+	return &cp
+}
+
+func (d *dedup) get(key dedupKey) (*Message, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.evict(d.now())
+
+	entry, ok := d.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	return copyMessage(entry.msg), true
+}
+
+func (d *dedup) put(key dedupKey, msg *Message) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+
+	d.evict(now)
+
+	d.entries[key] = dedupEntry{msg: copyMessage(msg), sent: now}
+}
+
+var ErrIncorrectDedupWindow = errors.New("incorrect dedup window")
+
+// WithDedup suppresses sending a message with the same chat_id, text and
+// parse_mode within window, SendMessage returns the previously sent message instead.
+// It is best-effort: only messages sent by the same Client are remembered,
+// concurrent identical sends may still both go through.
+func WithDedup(window time.Duration) Option {
+	return func(cl *Client) error {
+		if window <= 0 {
+			return ErrIncorrectDedupWindow
+		}
+
+		cl.dedup = newDedup(window)
+
+		return nil
+	}
+}
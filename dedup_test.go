@@ -0,0 +1,163 @@
+//nolint:exhaustruct
+package tg
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Client_SendMessage_Dedup(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.Anything).Return(func(*http.Request) (*http.Response, error) {
+		return &http.Response{
+			Body: io.NopCloser(bytes.NewBufferString(`{"ok":true,"result":{"message_id":1}}`)),
+		}, nil
+	}).Times(3)
+
+	now := time.Now()
+
+	client := new(Client)
+	client.http = httpClient
+	client.dedup = newDedup(time.Minute)
+	client.dedup.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	first, err := client.SendMessage(ctx, 1, "test")
+	assert.NoError(t, err)
+
+	first.Text = "changed"
+
+	second, err := client.SendMessage(ctx, 1, "test")
+	assert.NoError(t, err)
+	assert.NotSame(t, first, second)
+	assert.Equal(t, int64(1), second.MessageID)
+	assert.Empty(t, second.Text)
+
+	_, err = client.SendMessage(ctx, 1, "test", ParseModeSendOption(HTMLParseMode))
+	assert.NoError(t, err)
+
+	now = now.Add(time.Minute)
+
+	third, err := client.SendMessage(ctx, 1, "test")
+	assert.NoError(t, err)
+	assert.NotSame(t, first, third)
+}
+
+func Test_newDedupKey(t *testing.T) {
+	t.Parallel()
+
+	key := func(opts ...SendOption) dedupKey {
+		sm, err := NewSendMessage(1, "test", opts...)
+		assert.NoError(t, err)
+
+		return newDedupKey(sm)
+	}
+
+	base := key()
+
+	assert.Equal(t, base, key())
+
+	tests := []struct {
+		desc string
+		opt  SendOption
+	}{
+		{
+			desc: "thread",
+			opt:  MessageThreadIDSendOption(1),
+		},
+		{
+			desc: "parse_mode",
+			opt:  ParseModeSendOption(HTMLParseMode),
+		},
+		{
+			desc: "reply_markup",
+			opt: ReplyMarkupSendOption(&InlineKeyboardMarkup{
+				InlineKeyboard: [][]InlineKeyboardButton{{{Text: "test", CallbackData: "test"}}},
+			}),
+		},
+		{
+			desc: "entities",
+			opt:  EntitiesSendOption(MessageEntity{Type: "bold", Offset: 0, Length: 4}),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.NotEqual(t, base, key(test.opt))
+		})
+	}
+}
+
+func Test_Client_SendMessage_DedupThreads(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.Anything).Return(func(*http.Request) (*http.Response, error) {
+		return &http.Response{
+			Body: io.NopCloser(bytes.NewBufferString(`{"ok":true,"result":{"message_id":1}}`)),
+		}, nil
+	}).Times(2)
+
+	client := new(Client)
+	client.http = httpClient
+	client.dedup = newDedup(time.Minute)
+
+	ctx := context.Background()
+
+	_, err := client.SendMessage(ctx, 1, "test", MessageThreadIDSendOption(1))
+	assert.NoError(t, err)
+
+	_, err = client.SendMessage(ctx, 1, "test", MessageThreadIDSendOption(2))
+	assert.NoError(t, err)
+}
+
+func Test_copyMessage(t *testing.T) {
+	t.Parallel()
+
+	msg := &Message{
+		MessageID: 1,
+		From:      &User{ID: 1},
+		Entities:  []MessageEntity{{Type: "text_mention", User: &User{ID: 2}}},
+	}
+
+	cp := copyMessage(msg)
+
+	assert.Equal(t, msg, cp)
+	assert.NotSame(t, msg.From, cp.From)
+	assert.NotSame(t, msg.Entities[0].User, cp.Entities[0].User)
+
+	cp.Entities[0].Type = "bold"
+	assert.Equal(t, "text_mention", msg.Entities[0].Type)
+}
+
+func Test_dedup_evict(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	dd := newDedup(time.Hour)
+	dd.now = func() time.Time { return now }
+
+	for i := range maxDedupEntries + 10 {
+		now = now.Add(time.Millisecond)
+
+		dd.put(newDedupKey(&SendMessage{BaseMessage: BaseMessage{ChatID: int64(i + 1), Text: "test"}}), &Message{})
+	}
+
+	assert.Len(t, dd.entries, maxDedupEntries)
+
+	_, ok := dd.get(newDedupKey(&SendMessage{BaseMessage: BaseMessage{ChatID: 1, Text: "test"}}))
+	assert.False(t, ok)
+}
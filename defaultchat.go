@@ -0,0 +1,46 @@
+package tg
+
+import (
+	"context"
+	"fmt"
+)
+
+// WithDefaultChatID sets the chat used by SendTo, EditIn and DeleteIn.
+func WithDefaultChatID(chatID int64) Option {
+	return func(cl *Client) error {
+		if chatID == 0 {
+			return ErrEmptyChatID
+		}
+
+		cl.defaultChatID = chatID
+
+		return nil
+	}
+}
+
+// SendTo sends the message to the default chat (see WithDefaultChatID).
+func (c *Client) SendTo(ctx context.Context, text string, opts ...SendOption) (*Message, error) {
+	if c.defaultChatID == 0 {
+		return nil, fmt.Errorf("SendTo: %w", ErrEmptyChatID)
+	}
+
+	return c.SendMessage(ctx, c.defaultChatID, text, opts...)
+}
+
+// EditIn edits the message in the default chat (see WithDefaultChatID).
+func (c *Client) EditIn(ctx context.Context, messageID int64, text string, opts ...EditOption) (*Message, error) {
+	if c.defaultChatID == 0 {
+		return nil, fmt.Errorf("EditIn: %w", ErrEmptyChatID)
+	}
+
+	return c.EditMessage(ctx, c.defaultChatID, messageID, text, opts...)
+}
+
+// DeleteIn deletes the message in the default chat (see WithDefaultChatID).
+func (c *Client) DeleteIn(ctx context.Context, messageID int64, opts ...DeleteOption) (bool, error) {
+	if c.defaultChatID == 0 {
+		return false, fmt.Errorf("DeleteIn: %w", ErrEmptyChatID)
+	}
+
+	return c.DeleteMessage(ctx, c.defaultChatID, messageID, opts...)
+}
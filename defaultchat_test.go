@@ -0,0 +1,85 @@
+//nolint:exhaustruct
+package tg
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_WithDefaultChatID(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewClient(testToken, WithDefaultChatID(0))
+
+	assert.ErrorIs(t, err, ErrEmptyChatID)
+}
+
+func Test_Client_DefaultChatID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		chatID int64
+		result error
+	}{
+		{
+			desc:   "default",
+			chatID: -100,
+			result: nil,
+		},
+		{
+			desc:   ErrEmptyChatID.Error(),
+			chatID: 0,
+			result: ErrEmptyChatID,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			httpClient := &mockHTTPClient{}
+			httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+				body, _ := io.ReadAll(req.Body)
+
+				return strings.Contains(string(body), `"chat_id":-100,`)
+			})).Return(func(req *http.Request) (*http.Response, error) {
+				body := `{"ok":true,"result":{"message_id":1,"chat":{"id":-100}}}`
+				if strings.HasSuffix(req.URL.Path, deleteMessageMethod) {
+					body = `{"ok":true,"result":true}`
+				}
+
+				return &http.Response{Body: io.NopCloser(bytes.NewBufferString(body))}, nil
+			})
+
+			client := new(Client)
+			client.http = httpClient
+
+			if test.chatID != 0 {
+				assert.NoError(t, WithDefaultChatID(test.chatID)(client))
+			}
+
+			_, err := client.SendTo(context.Background(), "test")
+			assert.ErrorIs(t, err, test.result)
+
+			_, err = client.EditIn(context.Background(), 1, "test")
+			assert.ErrorIs(t, err, test.result)
+
+			_, err = client.DeleteIn(context.Background(), 1)
+			assert.ErrorIs(t, err, test.result)
+
+			if test.result != nil {
+				httpClient.AssertNotCalled(t, "Do", mock.Anything)
+			} else {
+				httpClient.AssertNumberOfCalls(t, "Do", 3)
+			}
+		})
+	}
+}
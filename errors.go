@@ -0,0 +1,85 @@
+package tg
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// The sentinel errors below are matched by errors.Is against the errors
+// returned by the API server (ResponseError).
+//
+// Telegram documents only the error codes, the descriptions are free-form
+// text that may change without notice, so a description is matched only when
+// the code alone is ambiguous (e.g. 400 covers most of the bad requests).
+var (
+	ErrUnauthorized       = errors.New("unauthorized")
+	ErrChatNotFound       = errors.New("chat not found")
+	ErrBotWasBlocked      = errors.New("bot was blocked")
+	ErrMessageNotModified = errors.New("message is not modified")
+	ErrTooManyRequests    = errors.New("too many requests")
+	ErrCantParseEntities  = errors.New("can't parse entities")
+)
+
+type responseErrorMatch struct {
+	code   int
+	substr string
+}
+
+//nolint:gochecknoglobals
+var responseErrorMatches = map[error]responseErrorMatch{
+	ErrUnauthorized:       {code: http.StatusUnauthorized},
+	ErrChatNotFound:       {code: http.StatusBadRequest, substr: "chat not found"},
+	ErrBotWasBlocked:      {code: http.StatusForbidden, substr: "blocked"},
+	ErrMessageNotModified: {code: http.StatusBadRequest, substr: "message is not modified"},
+	ErrTooManyRequests:    {code: http.StatusTooManyRequests},
+	ErrCantParseEntities:  {code: http.StatusBadRequest, substr: "can't parse entities"},
+}
+
+// Is reports whether the error matches one of the sentinel errors
+// (ErrChatNotFound, ErrTooManyRequests, ...) by the code and the description.
+func (r ResponseError) Is(target error) bool {
+	match, ok := responseErrorMatches[target]
+	if !ok || r.ErrorCode != match.code {
+		return false
+	}
+
+	return strings.Contains(strings.ToLower(r.Description), match.substr)
+}
+
+func responseError(err error) (ResponseError, bool) {
+	var respErr ResponseError
+
+	ok := errors.As(err, &respErr)
+
+	return respErr, ok
+}
+
+// IsChatNotFound reports whether err is "Bad Request: chat not found",
+// usually a wrong chat id or a chat the bot has never been added to.
+func IsChatNotFound(err error) bool {
+	return errors.Is(err, ErrChatNotFound)
+}
+
+// IsCantParseEntities reports whether err is "Bad Request: can't parse entities",
+// the text has a markup invalid for its parse mode (e.g. an unescaped "_").
+func IsCantParseEntities(err error) bool {
+	return errors.Is(err, ErrCantParseEntities)
+}
+
+// IsBotBlocked reports whether err is "Forbidden: bot was blocked by the user".
+func IsBotBlocked(err error) bool {
+	return errors.Is(err, ErrBotWasBlocked)
+}
+
+// IsMessageNotModified reports whether err is "Bad Request: message is not modified",
+// returned by an edit that doesn't change the message and usually safe to ignore.
+func IsMessageNotModified(err error) bool {
+	return errors.Is(err, ErrMessageNotModified)
+}
+
+// IsTooManyRequests reports whether err is the flood control error,
+// the delay is in ResponseError.Parameters.RetryAfter.
+func IsTooManyRequests(err error) bool {
+	return errors.Is(err, ErrTooManyRequests)
+}
@@ -0,0 +1,231 @@
+//nolint:exhaustruct
+package tg
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_IsErrors(t *testing.T) {
+	t.Parallel()
+
+	type predicate func(error) bool
+
+	tests := []struct {
+		desc string
+		body string
+	}{
+		{
+			desc: "chat_not_found",
+			body: `{"ok":false,"error_code":400,"description":"Bad Request: chat not found"}`,
+		},
+		{
+			desc: "bot_blocked",
+			body: `{"ok":false,"error_code":403,"description":"Forbidden: bot was blocked by the user"}`,
+		},
+		{
+			desc: "message_not_modified",
+			body: `{"ok":false,"error_code":400,"description":"Bad Request: message is not modified: ` +
+				`specified new message content and reply markup are exactly the same"}`,
+		},
+		{
+			desc: "too_many_requests",
+			body: `{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 5",` +
+				`"parameters":{"retry_after":5}}`,
+		},
+	}
+
+	predicates := map[string]predicate{
+		"chat_not_found":       IsChatNotFound,
+		"bot_blocked":          IsBotBlocked,
+		"message_not_modified": IsMessageNotModified,
+		"too_many_requests":    IsTooManyRequests,
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			httpClient := newMockHTTPClient(t)
+			httpClient.On("Do", mock.Anything).Return(func(*http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusBadRequest,
+					Body:       io.NopCloser(bytes.NewBufferString(test.body)),
+				}, nil
+			})
+
+			client := new(Client)
+			client.http = httpClient
+
+			_, err := client.SendMessage(context.Background(), 1, "test")
+			assert.Error(t, err)
+
+			for name, is := range predicates {
+				assert.Equal(t, name == test.desc, is(err), name)
+			}
+		})
+	}
+}
+
+func Test_IsErrors_Other(t *testing.T) {
+	t.Parallel()
+
+	for _, err := range []error{
+		nil,
+		errors.New("chat not found"),
+		fmt.Errorf("wrap: %w", ResponseError{ErrorCode: 400, Description: "Bad Request: message text is empty"}),
+	} {
+		assert.False(t, IsChatNotFound(err))
+		assert.False(t, IsBotBlocked(err))
+		assert.False(t, IsMessageNotModified(err))
+		assert.False(t, IsTooManyRequests(err))
+	}
+}
+
+func Test_ResponseError_Is(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		err    ResponseError
+		target error
+		result bool
+	}{
+		{
+			desc:   ErrUnauthorized.Error(),
+			err:    ResponseError{ErrorCode: 401, Description: "Unauthorized"},
+			target: ErrUnauthorized,
+			result: true,
+		},
+		{
+			desc:   ErrChatNotFound.Error(),
+			err:    ResponseError{ErrorCode: 400, Description: "Bad Request: chat not found"},
+			target: ErrChatNotFound,
+			result: true,
+		},
+		{
+			desc:   ErrBotWasBlocked.Error(),
+			err:    ResponseError{ErrorCode: 403, Description: "Forbidden: bot was blocked by the user"},
+			target: ErrBotWasBlocked,
+			result: true,
+		},
+		{
+			desc:   ErrTooManyRequests.Error(),
+			err:    ResponseError{ErrorCode: 429, Description: "Too Many Requests: retry after 5"},
+			target: ErrTooManyRequests,
+			result: true,
+		},
+		{
+			desc:   "other_code",
+			err:    ResponseError{ErrorCode: 403, Description: "Forbidden: bot was kicked from the group chat"},
+			target: ErrBotWasBlocked,
+			result: false,
+		},
+		{
+			desc:   "other_description",
+			err:    ResponseError{ErrorCode: 400, Description: "Bad Request: message text is empty"},
+			target: ErrChatNotFound,
+			result: false,
+		},
+		{
+			desc:   "other_target",
+			err:    ResponseError{ErrorCode: 400, Description: "Bad Request: chat not found"},
+			target: ErrEmptyChatID,
+			result: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.result, errors.Is(fmt.Errorf("SendMessage: %w", test.err), test.target))
+		})
+	}
+}
+
+func Test_Client_API_ResponseError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		status int
+		body   string
+		result ResponseError
+	}{
+		{
+			desc:   "too_many_requests",
+			status: http.StatusTooManyRequests,
+			body: `{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 7",` +
+				`"parameters":{"retry_after":7}}`,
+			result: ResponseError{
+				ErrorCode:   429,
+				Description: "Too Many Requests: retry after 7",
+				Parameters:  ResponseParameters{RetryAfter: 7},
+			},
+		},
+		{
+			desc:   "fractional_retry_after",
+			status: http.StatusTooManyRequests,
+			body: `{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 1.5",` +
+				`"parameters":{"retry_after":1.5}}`,
+			result: ResponseError{
+				ErrorCode:   429,
+				Description: "Too Many Requests: retry after 1.5",
+				Parameters:  ResponseParameters{RetryAfter: 2},
+			},
+		},
+		{
+			desc:   "migrate_to_chat_id",
+			status: http.StatusBadRequest,
+			body: `{"ok":false,"error_code":400,"description":"Bad Request: group chat was upgraded to a supergroup chat",` +
+				`"parameters":{"migrate_to_chat_id":-100123}}`,
+			result: ResponseError{
+				ErrorCode:   400,
+				Description: "Bad Request: group chat was upgraded to a supergroup chat",
+				Parameters:  ResponseParameters{MigrateToChatID: -100123},
+			},
+		},
+		{
+			desc:   "status_code",
+			status: http.StatusConflict,
+			body:   `{"ok":false,"description":"Conflict"}`,
+			result: ResponseError{
+				ErrorCode:   409,
+				Description: "Conflict",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			httpClient := newMockHTTPClient(t)
+			httpClient.On("Do", mock.Anything).Return(func(*http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: test.status,
+					Body:       io.NopCloser(bytes.NewBufferString(test.body)),
+				}, nil
+			})
+
+			client := new(Client)
+			client.http = httpClient
+
+			_, err := client.SendMessage(context.Background(), 1, "test")
+
+			var respErr ResponseError
+
+			assert.True(t, errors.As(err, &respErr))
+			assert.Equal(t, test.result, respErr)
+		})
+	}
+}
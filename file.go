@@ -0,0 +1,106 @@
+package tg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type File struct {
+	FileID       string `json:"file_id"`
+	FileUniqueID string `json:"file_unique_id"`
+	FileSize     int64  `json:"file_size,omitempty"`
+	// FilePath is valid for at least 1 hour, use DownloadFile to get the file.
+	FilePath string `json:"file_path,omitempty"`
+}
+
+type GetFile struct {
+	FileID string `json:"file_id"`
+}
+
+var (
+	ErrEmptyFileID   = errors.New("empty file_id")
+	ErrEmptyFilePath = errors.New("empty file_path")
+)
+
+func (gf *GetFile) Validate() error {
+	if gf.FileID == "" {
+		return ErrEmptyFileID
+	}
+
+	return nil
+}
+
+func NewGetFile(fileID string) (*GetFile, error) {
+	gf := &GetFile{
+		FileID: fileID,
+	}
+
+	if err := gf.Validate(); err != nil {
+		return nil, fmt.Errorf("GetFile: %w", err)
+	}
+
+	return gf, nil
+}
+
+const getFileMethod = "getFile"
+
+func (c *Client) GetFile(ctx context.Context, fileID string) (*File, error) {
+	req, err := NewGetFile(fileID)
+	if err != nil {
+		return nil, fmt.Errorf("GetFile: %w", err)
+	}
+
+	resp := new(File)
+
+	if err := c.API(ctx, getFileMethod, req, resp); err != nil {
+		return nil, fmt.Errorf("GetFile: %w", err)
+	}
+
+	return resp, nil
+}
+
+// DownloadFile writes the file with the FilePath returned by GetFile to w
+// and returns the number of bytes written, the file is downloaded from
+// the file server (see WithFileServer).
+func (c *Client) DownloadFile(ctx context.Context, filePath string, w io.Writer) (int64, error) {
+	if filePath == "" {
+		return 0, fmt.Errorf("DownloadFile: %w", ErrEmptyFilePath)
+	}
+
+	ctx, cancelTimeout := c.withMethodTimeout(ctx, "")
+	defer cancelTimeout()
+
+	ctx, cancel := c.withBaseContext(ctx)
+	defer cancel()
+
+	url, err := c.botURL(ctx, c.fileEndpoint, filePath)
+	if err != nil {
+		return 0, fmt.Errorf("DownloadFile: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, fmt.Errorf("DownloadFile: request: %w", redactError(err))
+	}
+
+	httpResp, err := c.http.Do(httpReq)
+	if err != nil {
+		return 0, fmt.Errorf("DownloadFile: request: %w", redactError(err))
+	}
+
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("DownloadFile: response: %w: %d", ErrUnexpectedStatus, httpResp.StatusCode)
+	}
+
+	written, err := io.Copy(w, httpResp.Body)
+	if err != nil {
+		return written, fmt.Errorf("DownloadFile: response: %w", err)
+	}
+
+	return written, nil
+}
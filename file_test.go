@@ -0,0 +1,148 @@
+//nolint:exhaustruct
+package tg
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_NewClient_FileServer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		opts   []Option
+		result string
+		err    error
+	}{
+		{
+			desc:   "default",
+			opts:   nil,
+			result: "https://api.telegram.org/file/bot" + testToken + "/",
+		},
+		{
+			desc:   "api_server",
+			opts:   []Option{WithAPIServer("http://localhost:8081/telegram/")},
+			result: "http://localhost:8081/telegram/file/bot" + testToken + "/",
+		},
+		{
+			desc: "file_server",
+			opts: []Option{
+				WithAPIServer("http://localhost:8081"),
+				WithFileServer("https://files.example.com/tg?query"),
+			},
+			result: "https://files.example.com/tg/bot" + testToken + "/",
+		},
+		{
+			desc: ErrIncorrectScheme.Error(),
+			opts: []Option{WithFileServer("ftp://files.example.com")},
+			err:  ErrIncorrectScheme,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			client, err := NewClient(testToken, test.opts...)
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+
+			url, err := client.botURL(context.Background(), client.fileEndpoint, "")
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.result, url)
+		})
+	}
+}
+
+func Test_Client_GetFile(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		body, _ := io.ReadAll(req.Body)
+
+		return req.URL.String() == getFileMethod && string(body) == `{"file_id":"test"}`
+	})).Return(
+		&http.Response{
+			Body: io.NopCloser(bytes.NewBufferString(
+				`{"ok":true,"result":{"file_id":"test","file_path":"photos/file_1.jpg"}}`,
+			)),
+		},
+		nil,
+	)
+
+	client := new(Client)
+	client.http = httpClient
+
+	file, err := client.GetFile(context.Background(), "test")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "photos/file_1.jpg", file.FilePath)
+
+	_, err = client.GetFile(context.Background(), "")
+
+	assert.ErrorIs(t, err, ErrEmptyFileID)
+}
+
+func Test_Client_DownloadFile(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		status int
+		result string
+		err    error
+	}{
+		{
+			desc:   "ok",
+			status: http.StatusOK,
+			result: "file",
+		},
+		{
+			desc:   ErrUnexpectedStatus.Error(),
+			status: http.StatusNotFound,
+			err:    ErrUnexpectedStatus,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			httpClient := newMockHTTPClient(t)
+			httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+				return req.Method == http.MethodGet &&
+					req.URL.String() == "http://localhost/file/bot"+testToken+"/photos/file_1.jpg"
+			})).Return(
+				&http.Response{
+					StatusCode: test.status,
+					Body:       io.NopCloser(bytes.NewBufferString("file")),
+				},
+				nil,
+			)
+
+			client, err := NewClient(testToken, WithAPIServer("http://localhost"), WithHTTPClient(httpClient))
+			assert.NoError(t, err)
+
+			buf := new(bytes.Buffer)
+
+			_, err = client.DownloadFile(context.Background(), "photos/file_1.jpg", buf)
+
+			assert.ErrorIs(t, err, test.err)
+			assert.Equal(t, test.result, buf.String())
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package tg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+const MaxForwardMessages int = 100
+
+var (
+	ErrEmptyFromChatID        = errors.New("empty from_chat_id")
+	ErrIncorrectMessageIDsLen = errors.New("incorrect message_ids length")
+)
+
+// MessageID is the identifier of a message returned by the copy and forward methods.
+type MessageID struct {
+	MessageID int64 `json:"message_id"`
+}
+
+type ForwardMessages struct {
+	ChatID              int64   `json:"chat_id"`
+	MessageThreadID     int64   `json:"message_thread_id,omitempty"`
+	FromChatID          int64   `json:"from_chat_id"`
+	MessageIDs          []int64 `json:"message_ids"`
+	DisableNotification bool    `json:"disable_notification,omitempty"`
+	ProtectContent      bool    `json:"protect_content,omitempty"`
+}
+
+func (fm *ForwardMessages) Validate() error {
+	if fm.ChatID == 0 {
+		return ErrEmptyChatID
+	}
+
+	if err := validateMessageThreadID(fm.MessageThreadID); err != nil {
+		return err
+	}
+
+	if fm.FromChatID == 0 {
+		return ErrEmptyFromChatID
+	}
+
+	if len(fm.MessageIDs) == 0 || len(fm.MessageIDs) > MaxForwardMessages {
+		return ErrIncorrectMessageIDsLen
+	}
+
+	for i, messageID := range fm.MessageIDs {
+		// the ids must be in strictly increasing order
+		if messageID <= 0 || (i > 0 && messageID <= fm.MessageIDs[i-1]) {
+			return ErrIncorrectMessageID
+		}
+	}
+
+	return nil
+}
+
+type ForwardOption func(*ForwardMessages)
+
+// NewForwardMessages sorts a copy of messageIDs, the order of the forwarded
+// messages is kept by the server.
+func NewForwardMessages(chatID, fromChatID int64, messageIDs []int64, opts ...ForwardOption) (*ForwardMessages, error) {
+	fm := new(ForwardMessages)
+
+	for _, opt := range opts {
+		opt(fm)
+	}
+
+	fm.ChatID = chatID
+	fm.FromChatID = fromChatID
+	fm.MessageIDs = slices.Clone(messageIDs)
+
+	slices.Sort(fm.MessageIDs)
+
+	if err := fm.Validate(); err != nil {
+		return nil, fmt.Errorf("ForwardMessages: %w", err)
+	}
+
+	return fm, nil
+}
+
+func MessageThreadIDForwardOption(threadID int64) ForwardOption {
+	return func(fm *ForwardMessages) {
+		fm.MessageThreadID = threadID
+	}
+}
+
+func DisableNotificationForwardOption(disable bool) ForwardOption {
+	return func(fm *ForwardMessages) {
+		fm.DisableNotification = disable
+	}
+}
+
+func ProtectContentForwardOption(protect bool) ForwardOption {
+	return func(fm *ForwardMessages) {
+		fm.ProtectContent = protect
+	}
+}
+
+const forwardMessagesMethod = "forwardMessages"
+
+// ForwardMessages forwards up to 100 messages and returns the ids of the sent
+// messages, the messages which can't be found or forwarded are skipped.
+func (c *Client) ForwardMessages(ctx context.Context,
+	chatID, fromChatID int64, messageIDs []int64, opts ...ForwardOption,
+) ([]int64, error) {
+	req, err := NewForwardMessages(chatID, fromChatID, messageIDs, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("ForwardMessages: %w", err)
+	}
+
+	if err := c.allowChat(chatID); err != nil {
+		return nil, fmt.Errorf("ForwardMessages: %w", err)
+	}
+
+	if err := c.waitChat(ctx, chatID); err != nil {
+		return nil, fmt.Errorf("ForwardMessages: %w", err)
+	}
+
+	resp := make([]MessageID, 0, len(req.MessageIDs))
+
+	if err := c.API(ctx, forwardMessagesMethod, req, &resp); err != nil {
+		return nil, fmt.Errorf("ForwardMessages: %w", err)
+	}
+
+	ids := make([]int64, 0, len(resp))
+
+	for _, id := range resp {
+		ids = append(ids, id.MessageID)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,96 @@
+//nolint:exhaustruct
+package tg
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_ForwardMessages_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		msg    *ForwardMessages
+		result error
+	}{
+		{
+			desc:   ErrEmptyChatID.Error(),
+			msg:    &ForwardMessages{},
+			result: ErrEmptyChatID,
+		},
+		{
+			desc:   ErrEmptyFromChatID.Error(),
+			msg:    &ForwardMessages{ChatID: 1},
+			result: ErrEmptyFromChatID,
+		},
+		{
+			desc:   ErrIncorrectMessageIDsLen.Error() + "_empty",
+			msg:    &ForwardMessages{ChatID: 1, FromChatID: 2},
+			result: ErrIncorrectMessageIDsLen,
+		},
+		{
+			desc:   ErrIncorrectMessageIDsLen.Error() + "_max",
+			msg:    &ForwardMessages{ChatID: 1, FromChatID: 2, MessageIDs: make([]int64, MaxForwardMessages+1)},
+			result: ErrIncorrectMessageIDsLen,
+		},
+		{
+			desc:   ErrIncorrectMessageID.Error(),
+			msg:    &ForwardMessages{ChatID: 1, FromChatID: 2, MessageIDs: []int64{0, 1}},
+			result: ErrIncorrectMessageID,
+		},
+		{
+			desc:   ErrIncorrectMessageID.Error() + "_order",
+			msg:    &ForwardMessages{ChatID: 1, FromChatID: 2, MessageIDs: []int64{2, 2}},
+			result: ErrIncorrectMessageID,
+		},
+		{
+			desc:   "nil_result",
+			msg:    &ForwardMessages{ChatID: 1, FromChatID: 2, MessageIDs: []int64{1, 2, 3}},
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.msg.Validate(), test.result)
+		})
+	}
+}
+
+func Test_Client_ForwardMessages(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		body, _ := io.ReadAll(req.Body)
+
+		return req.URL.String() == forwardMessagesMethod &&
+			string(body) == `{"chat_id":1,"from_chat_id":2,"message_ids":[3,5,7],"disable_notification":true}`
+	})).Return(
+		&http.Response{
+			Body: io.NopCloser(bytes.NewBufferString(
+				`{"ok":true,"result":[{"message_id":10},{"message_id":11}]}`,
+			)),
+		},
+		nil,
+	)
+
+	client := new(Client)
+	client.http = httpClient
+
+	ids, err := client.ForwardMessages(context.Background(), 1, 2, []int64{7, 3, 5},
+		DisableNotificationForwardOption(true),
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{10, 11}, ids)
+}
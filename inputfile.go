@@ -0,0 +1,149 @@
+package tg
+
+import (
+	"encoding/json"
+	"io"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// InputFile is a file to send: a URL or a file_id sent as a string,
+// or a local file uploaded with multipart/form-data.
+type InputFile struct {
+	value  string
+	name   string
+	reader io.Reader
+}
+
+// FileFromURL is a file which Telegram downloads from url.
+func FileFromURL(url string) InputFile {
+	return InputFile{value: url, name: "", reader: nil}
+}
+
+// FileFromID is a file already stored on the Telegram servers.
+func FileFromID(id string) InputFile {
+	return InputFile{value: id, name: "", reader: nil}
+}
+
+// FileFromReader is a file uploaded from r with the given file name.
+func FileFromReader(name string, r io.Reader) InputFile {
+	return InputFile{value: "", name: name, reader: r}
+}
+
+func (f InputFile) IsZero() bool {
+	return f.value == "" && f.reader == nil
+}
+
+// IsUpload reports whether the file requires a multipart/form-data request.
+func (f InputFile) IsUpload() bool {
+	return f.reader != nil
+}
+
+// MarshalJSON encodes a URL or a file_id as a string, an upload is sent
+// as a separate part of the multipart request and is encoded as null.
+func (f InputFile) MarshalJSON() ([]byte, error) {
+	if f.IsUpload() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(f.value) //nolint:wrapcheck
+}
+
+// uploadFile is a file sent as a part of a multipart/form-data request.
+type uploadFile struct {
+	field  string
+	name   string
+	reader io.Reader
+}
+
+//nolint:gochecknoglobals
+var inputFileType = reflect.TypeOf(InputFile{})
+
+// inputFiles returns the uploads of the InputFile fields of req,
+// the form field name is the json name of the struct field.
+func inputFiles(req any) []uploadFile {
+	value := reflect.Indirect(reflect.ValueOf(req))
+
+	switch value.Kind() { //nolint:exhaustive
+	case reflect.Struct:
+		return appendInputFiles(nil, value)
+	case reflect.Map:
+		return mapInputFiles(value)
+	default:
+		return nil
+	}
+}
+
+// mapInputFiles returns the uploads of the InputFile values of the map
+// with string keys (the Call parameters), the form field name is the key.
+func mapInputFiles(value reflect.Value) []uploadFile {
+	if value.Type().Key().Kind() != reflect.String {
+		return nil
+	}
+
+	keys := value.MapKeys()
+
+	slices.SortFunc(keys, func(a, b reflect.Value) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	var files []uploadFile
+
+	for _, key := range keys {
+		var file InputFile
+
+		switch elem := value.MapIndex(key).Interface().(type) {
+		case InputFile:
+			file = elem
+		case *InputFile:
+			if elem == nil {
+				continue
+			}
+
+			file = *elem
+		default:
+			continue
+		}
+
+		if file.IsUpload() {
+			files = append(files, uploadFile{field: key.String(), name: file.name, reader: file.reader})
+		}
+	}
+
+	return files
+}
+
+func appendInputFiles(files []uploadFile, value reflect.Value) []uploadFile {
+	for i := range value.NumField() {
+		field := value.Type().Field(i)
+		fieldValue := value.Field(i)
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+
+		if field.Anonymous && name == "" && fieldValue.Kind() == reflect.Struct {
+			files = appendInputFiles(files, fieldValue)
+
+			continue
+		}
+
+		if fieldValue.Kind() == reflect.Pointer {
+			if fieldValue.IsNil() {
+				continue
+			}
+
+			fieldValue = fieldValue.Elem()
+		}
+
+		if fieldValue.Type() != inputFileType || name == "" || name == "-" {
+			continue
+		}
+
+		file, _ := fieldValue.Interface().(InputFile)
+		if file.IsUpload() {
+			files = append(files, uploadFile{field: name, name: file.name, reader: file.reader})
+		}
+	}
+
+	return files
+}
@@ -0,0 +1,99 @@
+//nolint:exhaustruct
+package tg
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_InputFile_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		file   InputFile
+		upload bool
+		result string
+	}{
+		{
+			desc:   "url",
+			file:   FileFromURL("https://example.com/video.mp4"),
+			upload: false,
+			result: `"https://example.com/video.mp4"`,
+		},
+		{
+			desc:   "id",
+			file:   FileFromID("test"),
+			upload: false,
+			result: `"test"`,
+		},
+		{
+			desc:   "reader",
+			file:   FileFromReader("video.mp4", bytes.NewBufferString("video")),
+			upload: true,
+			result: `null`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			body, err := json.Marshal(test.file)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.result, string(body))
+			assert.Equal(t, test.upload, test.file.IsUpload())
+		})
+	}
+}
+
+func Test_inputFiles(t *testing.T) {
+	t.Parallel()
+
+	video := bytes.NewBufferString("video")
+	thumbnail := FileFromReader("thumb.jpg", bytes.NewBufferString("thumb"))
+
+	tests := []struct {
+		desc   string
+		req    any
+		result []uploadFile
+	}{
+		{
+			desc: "id",
+			req: &SendVideoMessage{
+				ChatID: 1,
+				Video:  FileFromID("test"),
+			},
+			result: nil,
+		},
+		{
+			desc: "upload",
+			req: &SendVideoMessage{
+				ChatID:    1,
+				Video:     FileFromReader("video.mp4", video),
+				Thumbnail: &thumbnail,
+			},
+			result: []uploadFile{
+				{field: "video", name: "video.mp4", reader: video},
+				{field: "thumbnail", name: "thumb.jpg", reader: thumbnail.reader},
+			},
+		},
+		{
+			desc:   "bool",
+			req:    new(bool),
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.result, inputFiles(test.req))
+		})
+	}
+}
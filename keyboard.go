@@ -0,0 +1,153 @@
+package tg
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+const MaxCallbackDataSize int = 64
+
+var (
+	ErrEmptyButtonText     = errors.New("empty button text")
+	ErrButtonAction        = errors.New("button must have exactly one action")
+	ErrCallbackDataTooLong = errors.New("callback_data too long")
+)
+
+type InlineKeyboardButton struct {
+	Text         string `json:"text"`
+	URL          string `json:"url,omitempty"`
+	CallbackData string `json:"callback_data,omitempty"`
+}
+
+func (b *InlineKeyboardButton) Validate() error {
+	if b.Text == "" {
+		return ErrEmptyButtonText
+	}
+
+	if (b.URL == "") == (b.CallbackData == "") {
+		return ErrButtonAction
+	}
+
+	if len(b.CallbackData) > MaxCallbackDataSize {
+		return ErrCallbackDataTooLong
+	}
+
+	return nil
+}
+
+// InlineKeyboardMarkup is the inline keyboard attached to a message,
+// the markup without buttons removes the keyboard from an edited message.
+type InlineKeyboardMarkup struct {
+	InlineKeyboard [][]InlineKeyboardButton `json:"inline_keyboard"`
+}
+
+func (m *InlineKeyboardMarkup) Validate() error {
+	for _, row := range m.InlineKeyboard {
+		for _, button := range row {
+			if err := button.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m InlineKeyboardMarkup) MarshalJSON() ([]byte, error) {
+	type markup InlineKeyboardMarkup
+
+	if m.InlineKeyboard == nil {
+		m.InlineKeyboard = [][]InlineKeyboardButton{}
+	}
+
+	return json.Marshal(markup(m)) //nolint:wrapcheck
+}
+
+const (
+	MaxInlineKeyboardRowSize = 8
+	MaxInlineKeyboardButtons = 100
+)
+
+var (
+	ErrInvalidButton  = errors.New("invalid button")
+	ErrRowTooLong     = errors.New("row too long")
+	ErrTooManyButtons = errors.New("too many buttons")
+	ErrEmptyKeyboard  = errors.New("empty keyboard")
+)
+
+// InlineKeyboard builds an InlineKeyboardMarkup row by row:
+//
+//	markup, err := NewInlineKeyboard().
+//		CallbackButton("Yes", "yes").CallbackButton("No", "no").
+//		Row().URLButton("Docs", "https://example.com").
+//		Build()
+type InlineKeyboard struct {
+	rows [][]InlineKeyboardButton
+}
+
+func NewInlineKeyboard() *InlineKeyboard {
+	return &InlineKeyboard{rows: [][]InlineKeyboardButton{{}}}
+}
+
+// Row starts a new row with the buttons, the next buttons are added to it.
+func (k *InlineKeyboard) Row(buttons ...InlineKeyboardButton) *InlineKeyboard {
+	k.rows = append(k.rows, slices.Clone(buttons))
+
+	return k
+}
+
+// Button adds the button to the current row.
+func (k *InlineKeyboard) Button(button InlineKeyboardButton) *InlineKeyboard {
+	k.rows[len(k.rows)-1] = append(k.rows[len(k.rows)-1], button)
+
+	return k
+}
+
+func (k *InlineKeyboard) URLButton(text, url string) *InlineKeyboard {
+	return k.Button(InlineKeyboardButton{Text: text, URL: url})
+}
+
+func (k *InlineKeyboard) CallbackButton(text, data string) *InlineKeyboard {
+	return k.Button(InlineKeyboardButton{Text: text, CallbackData: data})
+}
+
+// Build validates the buttons and returns the markup without the empty rows,
+// all the errors wrap ErrInvalidButton. A keyboard without buttons fails with
+// ErrEmptyKeyboard, use an empty InlineKeyboardMarkup to remove a keyboard.
+func (k *InlineKeyboard) Build() (*InlineKeyboardMarkup, error) {
+	markup := &InlineKeyboardMarkup{InlineKeyboard: make([][]InlineKeyboardButton, 0, len(k.rows))}
+	total := 0
+
+	for _, row := range k.rows {
+		if len(row) == 0 {
+			continue
+		}
+
+		i := len(markup.InlineKeyboard)
+
+		if len(row) > MaxInlineKeyboardRowSize {
+			return nil, fmt.Errorf("InlineKeyboard: %w: row %d: %w", ErrInvalidButton, i, ErrRowTooLong)
+		}
+
+		for j, button := range row {
+			if err := button.Validate(); err != nil {
+				return nil, fmt.Errorf("InlineKeyboard: %w: row %d button %d: %w", ErrInvalidButton, i, j, err)
+			}
+		}
+
+		total += len(row)
+		if total > MaxInlineKeyboardButtons {
+			return nil, fmt.Errorf("InlineKeyboard: %w: %w", ErrInvalidButton, ErrTooManyButtons)
+		}
+
+		markup.InlineKeyboard = append(markup.InlineKeyboard, slices.Clone(row))
+	}
+
+	if total == 0 {
+		return nil, fmt.Errorf("InlineKeyboard: %w: %w", ErrInvalidButton, ErrEmptyKeyboard)
+	}
+
+	return markup, nil
+}
@@ -0,0 +1,187 @@
+//nolint:exhaustruct
+package tg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_InlineKeyboardButton_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		button InlineKeyboardButton
+		result error
+	}{
+		{
+			desc:   ErrEmptyButtonText.Error(),
+			button: InlineKeyboardButton{URL: "https://example.com"},
+			result: ErrEmptyButtonText,
+		},
+		{
+			desc:   ErrButtonAction.Error() + "_none",
+			button: InlineKeyboardButton{Text: "test"},
+			result: ErrButtonAction,
+		},
+		{
+			desc:   ErrButtonAction.Error() + "_both",
+			button: InlineKeyboardButton{Text: "test", URL: "https://example.com", CallbackData: "test"},
+			result: ErrButtonAction,
+		},
+		{
+			desc:   ErrCallbackDataTooLong.Error(),
+			button: InlineKeyboardButton{Text: "test", CallbackData: strings.Repeat("a", MaxCallbackDataSize+1)},
+			result: ErrCallbackDataTooLong,
+		},
+		{
+			desc:   "nil_result",
+			button: InlineKeyboardButton{Text: "test", CallbackData: strings.Repeat("a", MaxCallbackDataSize)},
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.button.Validate(), test.result)
+		})
+	}
+}
+
+func Test_InlineKeyboardMarkup_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	body, err := json.Marshal(&InlineKeyboardMarkup{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, `{"inline_keyboard":[]}`, string(body))
+}
+
+func Test_InlineKeyboard_Build(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc     string
+		keyboard func() *InlineKeyboard
+		result   error
+	}{
+		{
+			desc: ErrButtonAction.Error(),
+			keyboard: func() *InlineKeyboard {
+				return NewInlineKeyboard().Row(InlineKeyboardButton{Text: "test"})
+			},
+			result: ErrButtonAction,
+		},
+		{
+			desc: ErrEmptyKeyboard.Error(),
+			keyboard: func() *InlineKeyboard {
+				return NewInlineKeyboard().Row().Row()
+			},
+			result: ErrEmptyKeyboard,
+		},
+		{
+			desc: ErrRowTooLong.Error(),
+			keyboard: func() *InlineKeyboard {
+				keyboard := NewInlineKeyboard()
+
+				for range MaxInlineKeyboardRowSize + 1 {
+					keyboard.CallbackButton("test", "test")
+				}
+
+				return keyboard
+			},
+			result: ErrRowTooLong,
+		},
+		{
+			desc: ErrTooManyButtons.Error(),
+			keyboard: func() *InlineKeyboard {
+				keyboard := NewInlineKeyboard()
+
+				for i := range MaxInlineKeyboardButtons + 1 {
+					if i%MaxInlineKeyboardRowSize == 0 {
+						keyboard.Row()
+					}
+
+					keyboard.CallbackButton("test", "test")
+				}
+
+				return keyboard
+			},
+			result: ErrTooManyButtons,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.keyboard().Build()
+
+			assert.ErrorIs(t, err, ErrInvalidButton)
+			assert.ErrorIs(t, err, test.result)
+		})
+	}
+}
+
+func Test_InlineKeyboard_Build_Rows(t *testing.T) {
+	t.Parallel()
+
+	markup, err := NewInlineKeyboard().
+		CallbackButton("Yes", "yes").CallbackButton("No", "no").
+		Row().
+		Row(InlineKeyboardButton{Text: "Docs", URL: "https://example.com"}).
+		URLButton("Site", "https://example.org").
+		Build()
+
+	assert.NoError(t, err)
+	assert.Equal(t, &InlineKeyboardMarkup{InlineKeyboard: [][]InlineKeyboardButton{
+		{{Text: "Yes", CallbackData: "yes"}, {Text: "No", CallbackData: "no"}},
+		{{Text: "Docs", URL: "https://example.com"}, {Text: "Site", URL: "https://example.org"}},
+	}}, markup)
+}
+
+func Test_Client_EditMessage_ReplyMarkup(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		body, _ := io.ReadAll(req.Body)
+
+		return string(body) == `{"message_id":2,"chat_id":1,"text":"test",`+
+			`"reply_markup":{"inline_keyboard":[[{"text":"ok","callback_data":"ok"}]]}}`
+	})).Return(
+		&http.Response{
+			Body: io.NopCloser(bytes.NewBufferString(`{"ok":true,"result":{"message_id":2}}`)),
+		},
+		nil,
+	)
+
+	client := new(Client)
+	client.http = httpClient
+
+	_, err := client.EditMessage(context.Background(), 1, 2, "test",
+		ReplyMarkupEditOption(&InlineKeyboardMarkup{
+			InlineKeyboard: [][]InlineKeyboardButton{{{Text: "ok", CallbackData: "ok"}}},
+		}),
+	)
+
+	assert.NoError(t, err)
+
+	_, err = client.EditMessage(context.Background(), 1, 2, "test",
+		ReplyMarkupEditOption(&InlineKeyboardMarkup{
+			InlineKeyboard: [][]InlineKeyboardButton{{{Text: "ok"}}},
+		}),
+	)
+
+	assert.ErrorIs(t, err, ErrButtonAction)
+}
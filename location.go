@@ -0,0 +1,430 @@
+package tg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+)
+
+const (
+	MaxLatitude           float64 = 90
+	MaxLongitude          float64 = 180
+	MaxHorizontalAccuracy float64 = 1500
+	MinLivePeriod         int     = 60
+	MaxLivePeriod         int     = 86400
+)
+
+var (
+	ErrIncorrectLatitude           = errors.New("incorrect latitude")
+	ErrIncorrectLongitude          = errors.New("incorrect longitude")
+	ErrIncorrectHorizontalAccuracy = errors.New("incorrect horizontal_accuracy")
+	ErrIncorrectLivePeriod         = errors.New("incorrect live_period")
+	ErrEmptyVenueTitle             = errors.New("empty title")
+	ErrEmptyVenueAddress           = errors.New("empty address")
+)
+
+type Location struct {
+	Latitude           float64 `json:"latitude"`
+	Longitude          float64 `json:"longitude"`
+	HorizontalAccuracy float64 `json:"horizontal_accuracy,omitempty"`
+	LivePeriod         int     `json:"live_period,omitempty"`
+}
+
+type Venue struct {
+	Location      Location `json:"location"`
+	Title         string   `json:"title"`
+	Address       string   `json:"address"`
+	FoursquareID  string   `json:"foursquare_id,omitempty"`
+	GooglePlaceID string   `json:"google_place_id,omitempty"`
+}
+
+func validateCoordinates(latitude, longitude float64) error {
+	// NaN isn't caught by the range checks
+	if math.IsNaN(latitude) || math.IsInf(latitude, 0) || latitude < -MaxLatitude || latitude > MaxLatitude {
+		return ErrIncorrectLatitude
+	}
+
+	if math.IsNaN(longitude) || math.IsInf(longitude, 0) || longitude < -MaxLongitude || longitude > MaxLongitude {
+		return ErrIncorrectLongitude
+	}
+
+	return nil
+}
+
+type SendLocationMessage struct {
+	ChatID             int64   `json:"chat_id"`
+	MessageThreadID    int64   `json:"message_thread_id,omitempty"`
+	Latitude           float64 `json:"latitude"`
+	Longitude          float64 `json:"longitude"`
+	HorizontalAccuracy float64 `json:"horizontal_accuracy,omitempty"`
+	LivePeriod         int     `json:"live_period,omitempty"`
+}
+
+func (sl *SendLocationMessage) Validate() error {
+	if sl.ChatID == 0 {
+		return ErrEmptyChatID
+	}
+
+	if err := validateMessageThreadID(sl.MessageThreadID); err != nil {
+		return err
+	}
+
+	if err := validateCoordinates(sl.Latitude, sl.Longitude); err != nil {
+		return err
+	}
+
+	if sl.HorizontalAccuracy < 0 || sl.HorizontalAccuracy > MaxHorizontalAccuracy {
+		return ErrIncorrectHorizontalAccuracy
+	}
+
+	if sl.LivePeriod != 0 && (sl.LivePeriod < MinLivePeriod || sl.LivePeriod > MaxLivePeriod) {
+		return ErrIncorrectLivePeriod
+	}
+
+	return nil
+}
+
+type SendLocationOption func(*SendLocationMessage)
+
+func NewSendLocationMessage(chatID int64,
+	latitude, longitude float64, opts ...SendLocationOption,
+) (*SendLocationMessage, error) {
+	sl := new(SendLocationMessage)
+
+	for _, opt := range opts {
+		opt(sl)
+	}
+
+	sl.ChatID = chatID
+	sl.Latitude = latitude
+	sl.Longitude = longitude
+
+	if err := sl.Validate(); err != nil {
+		return nil, fmt.Errorf("SendLocationMessage: %w", err)
+	}
+
+	return sl, nil
+}
+
+func MessageThreadIDSendLocationOption(threadID int64) SendLocationOption {
+	return func(sl *SendLocationMessage) {
+		sl.MessageThreadID = threadID
+	}
+}
+
+// HorizontalAccuracySendLocationOption sets the radius of uncertainty in meters, 0-1500.
+func HorizontalAccuracySendLocationOption(accuracy float64) SendLocationOption {
+	return func(sl *SendLocationMessage) {
+		sl.HorizontalAccuracy = accuracy
+	}
+}
+
+// LivePeriodSendLocationOption makes the location live for period seconds, 60-86400.
+func LivePeriodSendLocationOption(period int) SendLocationOption {
+	return func(sl *SendLocationMessage) {
+		sl.LivePeriod = period
+	}
+}
+
+const sendLocationMethod = "sendLocation"
+
+func (c *Client) SendLocation(ctx context.Context,
+	chatID int64, latitude, longitude float64, opts ...SendLocationOption,
+) (*Message, error) {
+	req, err := NewSendLocationMessage(chatID, latitude, longitude, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("SendLocation: %w", err)
+	}
+
+	if err := c.allowChat(chatID); err != nil {
+		return nil, fmt.Errorf("SendLocation: %w", err)
+	}
+
+	if err := c.waitChat(ctx, chatID); err != nil {
+		return nil, fmt.Errorf("SendLocation: %w", err)
+	}
+
+	resp := new(Message)
+
+	if err := c.API(ctx, sendLocationMethod, req, resp); err != nil {
+		return nil, fmt.Errorf("SendLocation: %w", err)
+	}
+
+	return resp, nil
+}
+
+type SendVenueMessage struct {
+	ChatID          int64   `json:"chat_id"`
+	MessageThreadID int64   `json:"message_thread_id,omitempty"`
+	Latitude        float64 `json:"latitude"`
+	Longitude       float64 `json:"longitude"`
+	Title           string  `json:"title"`
+	Address         string  `json:"address"`
+	FoursquareID    string  `json:"foursquare_id,omitempty"`
+	GooglePlaceID   string  `json:"google_place_id,omitempty"`
+}
+
+func (sv *SendVenueMessage) Validate() error {
+	if sv.ChatID == 0 {
+		return ErrEmptyChatID
+	}
+
+	if err := validateMessageThreadID(sv.MessageThreadID); err != nil {
+		return err
+	}
+
+	if err := validateCoordinates(sv.Latitude, sv.Longitude); err != nil {
+		return err
+	}
+
+	if sv.Title == "" {
+		return ErrEmptyVenueTitle
+	}
+
+	if sv.Address == "" {
+		return ErrEmptyVenueAddress
+	}
+
+	return nil
+}
+
+type SendVenueOption func(*SendVenueMessage)
+
+func NewSendVenueMessage(chatID int64,
+	latitude, longitude float64, title, address string, opts ...SendVenueOption,
+) (*SendVenueMessage, error) {
+	sv := new(SendVenueMessage)
+
+	for _, opt := range opts {
+		opt(sv)
+	}
+
+	sv.ChatID = chatID
+	sv.Latitude = latitude
+	sv.Longitude = longitude
+	sv.Title = title
+	sv.Address = address
+
+	if err := sv.Validate(); err != nil {
+		return nil, fmt.Errorf("SendVenueMessage: %w", err)
+	}
+
+	return sv, nil
+}
+
+func MessageThreadIDSendVenueOption(threadID int64) SendVenueOption {
+	return func(sv *SendVenueMessage) {
+		sv.MessageThreadID = threadID
+	}
+}
+
+func FoursquareIDSendVenueOption(id string) SendVenueOption {
+	return func(sv *SendVenueMessage) {
+		sv.FoursquareID = id
+	}
+}
+
+func GooglePlaceIDSendVenueOption(id string) SendVenueOption {
+	return func(sv *SendVenueMessage) {
+		sv.GooglePlaceID = id
+	}
+}
+
+const sendVenueMethod = "sendVenue"
+
+func (c *Client) SendVenue(ctx context.Context,
+	chatID int64, latitude, longitude float64, title, address string, opts ...SendVenueOption,
+) (*Message, error) {
+	req, err := NewSendVenueMessage(chatID, latitude, longitude, title, address, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("SendVenue: %w", err)
+	}
+
+	if err := c.allowChat(chatID); err != nil {
+		return nil, fmt.Errorf("SendVenue: %w", err)
+	}
+
+	if err := c.waitChat(ctx, chatID); err != nil {
+		return nil, fmt.Errorf("SendVenue: %w", err)
+	}
+
+	resp := new(Message)
+
+	if err := c.API(ctx, sendVenueMethod, req, resp); err != nil {
+		return nil, fmt.Errorf("SendVenue: %w", err)
+	}
+
+	return resp, nil
+}
+
+const (
+	MinHeading int = 1
+	MaxHeading int = 360
+)
+
+var ErrIncorrectHeading = errors.New("incorrect heading")
+
+type EditMessageLiveLocation struct {
+	ChatID             int64   `json:"chat_id"`
+	MessageID          int64   `json:"message_id"`
+	Latitude           float64 `json:"latitude"`
+	Longitude          float64 `json:"longitude"`
+	HorizontalAccuracy float64 `json:"horizontal_accuracy,omitempty"`
+	Heading            int     `json:"heading,omitempty"`
+	BusinessConnection
+}
+
+func (el *EditMessageLiveLocation) Validate() error {
+	if el.ChatID == 0 {
+		return ErrEmptyChatID
+	}
+
+	if el.MessageID <= 0 {
+		return ErrIncorrectMessageID
+	}
+
+	if err := validateCoordinates(el.Latitude, el.Longitude); err != nil {
+		return err
+	}
+
+	if el.HorizontalAccuracy < 0 || el.HorizontalAccuracy > MaxHorizontalAccuracy {
+		return ErrIncorrectHorizontalAccuracy
+	}
+
+	if el.Heading != 0 && (el.Heading < MinHeading || el.Heading > MaxHeading) {
+		return ErrIncorrectHeading
+	}
+
+	return el.BusinessConnection.Validate()
+}
+
+type EditLiveLocationOption func(*EditMessageLiveLocation)
+
+func NewEditMessageLiveLocation(chatID, messageID int64,
+	latitude, longitude float64, opts ...EditLiveLocationOption,
+) (*EditMessageLiveLocation, error) {
+	el := new(EditMessageLiveLocation)
+
+	for _, opt := range opts {
+		opt(el)
+	}
+
+	el.ChatID = chatID
+	el.MessageID = messageID
+	el.Latitude = latitude
+	el.Longitude = longitude
+
+	if err := el.Validate(); err != nil {
+		return nil, fmt.Errorf("EditMessageLiveLocation: %w", err)
+	}
+
+	return el, nil
+}
+
+// HorizontalAccuracyEditLiveLocationOption sets the radius of uncertainty in meters, 0-1500.
+func HorizontalAccuracyEditLiveLocationOption(accuracy float64) EditLiveLocationOption {
+	return func(el *EditMessageLiveLocation) {
+		el.HorizontalAccuracy = accuracy
+	}
+}
+
+// HeadingEditLiveLocationOption sets the direction of the movement in degrees, 1-360.
+func HeadingEditLiveLocationOption(heading int) EditLiveLocationOption {
+	return func(el *EditMessageLiveLocation) {
+		el.Heading = heading
+	}
+}
+
+const editMessageLiveLocationMethod = "editMessageLiveLocation"
+
+func (c *Client) EditMessageLiveLocation(ctx context.Context,
+	chatID, messageID int64, latitude, longitude float64, opts ...EditLiveLocationOption,
+) (*Message, error) {
+	req, err := NewEditMessageLiveLocation(chatID, messageID, latitude, longitude, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("EditMessageLiveLocation: %w", err)
+	}
+
+	if err := c.allowChat(chatID); err != nil {
+		return nil, fmt.Errorf("EditMessageLiveLocation: %w", err)
+	}
+
+	if err := c.waitChat(ctx, chatID); err != nil {
+		return nil, fmt.Errorf("EditMessageLiveLocation: %w", err)
+	}
+
+	resp := new(Message)
+
+	if err := c.API(ctx, editMessageLiveLocationMethod, req, resp); err != nil {
+		return nil, fmt.Errorf("EditMessageLiveLocation: %w", err)
+	}
+
+	return resp, nil
+}
+
+type StopMessageLiveLocation struct {
+	ChatID    int64 `json:"chat_id"`
+	MessageID int64 `json:"message_id"`
+	BusinessConnection
+}
+
+func (sl *StopMessageLiveLocation) Validate() error {
+	if sl.ChatID == 0 {
+		return ErrEmptyChatID
+	}
+
+	if sl.MessageID <= 0 {
+		return ErrIncorrectMessageID
+	}
+
+	return sl.BusinessConnection.Validate()
+}
+
+type StopLiveLocationOption func(*StopMessageLiveLocation)
+
+func NewStopMessageLiveLocation(chatID, messageID int64,
+	opts ...StopLiveLocationOption,
+) (*StopMessageLiveLocation, error) {
+	sl := new(StopMessageLiveLocation)
+
+	for _, opt := range opts {
+		opt(sl)
+	}
+
+	sl.ChatID = chatID
+	sl.MessageID = messageID
+
+	if err := sl.Validate(); err != nil {
+		return nil, fmt.Errorf("StopMessageLiveLocation: %w", err)
+	}
+
+	return sl, nil
+}
+
+const stopMessageLiveLocationMethod = "stopMessageLiveLocation"
+
+func (c *Client) StopMessageLiveLocation(ctx context.Context,
+	chatID, messageID int64, opts ...StopLiveLocationOption,
+) (*Message, error) {
+	req, err := NewStopMessageLiveLocation(chatID, messageID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("StopMessageLiveLocation: %w", err)
+	}
+
+	if err := c.allowChat(chatID); err != nil {
+		return nil, fmt.Errorf("StopMessageLiveLocation: %w", err)
+	}
+
+	if err := c.waitChat(ctx, chatID); err != nil {
+		return nil, fmt.Errorf("StopMessageLiveLocation: %w", err)
+	}
+
+	resp := new(Message)
+
+	if err := c.API(ctx, stopMessageLiveLocationMethod, req, resp); err != nil {
+		return nil, fmt.Errorf("StopMessageLiveLocation: %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,286 @@
+//nolint:exhaustruct
+package tg
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_SendLocationMessage_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		msg    func() *SendLocationMessage
+		result error
+	}{
+		{
+			desc:   ErrEmptyChatID.Error(),
+			msg:    func() *SendLocationMessage { return &SendLocationMessage{} },
+			result: ErrEmptyChatID,
+		},
+		{
+			desc:   ErrIncorrectLatitude.Error(),
+			msg:    func() *SendLocationMessage { return &SendLocationMessage{ChatID: 1, Latitude: -90.1} },
+			result: ErrIncorrectLatitude,
+		},
+		{
+			desc:   ErrIncorrectLongitude.Error(),
+			msg:    func() *SendLocationMessage { return &SendLocationMessage{ChatID: 1, Longitude: 180.1} },
+			result: ErrIncorrectLongitude,
+		},
+		{
+			desc:   "latitude_nan",
+			msg:    func() *SendLocationMessage { return &SendLocationMessage{ChatID: 1, Latitude: math.NaN()} },
+			result: ErrIncorrectLatitude,
+		},
+		{
+			desc:   "latitude_inf",
+			msg:    func() *SendLocationMessage { return &SendLocationMessage{ChatID: 1, Latitude: math.Inf(-1)} },
+			result: ErrIncorrectLatitude,
+		},
+		{
+			desc:   "longitude_nan",
+			msg:    func() *SendLocationMessage { return &SendLocationMessage{ChatID: 1, Longitude: math.NaN()} },
+			result: ErrIncorrectLongitude,
+		},
+		{
+			desc:   "longitude_inf",
+			msg:    func() *SendLocationMessage { return &SendLocationMessage{ChatID: 1, Longitude: math.Inf(1)} },
+			result: ErrIncorrectLongitude,
+		},
+		{
+			desc: ErrIncorrectHorizontalAccuracy.Error(),
+			msg: func() *SendLocationMessage {
+				return &SendLocationMessage{ChatID: 1, HorizontalAccuracy: MaxHorizontalAccuracy + 1}
+			},
+			result: ErrIncorrectHorizontalAccuracy,
+		},
+		{
+			desc:   ErrIncorrectLivePeriod.Error(),
+			msg:    func() *SendLocationMessage { return &SendLocationMessage{ChatID: 1, LivePeriod: 59} },
+			result: ErrIncorrectLivePeriod,
+		},
+		{
+			desc: "nil_result",
+			msg: func() *SendLocationMessage {
+				return &SendLocationMessage{ChatID: 1, Latitude: 90, Longitude: -180, LivePeriod: MaxLivePeriod}
+			},
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.msg().Validate(), test.result)
+		})
+	}
+}
+
+func Test_SendVenueMessage_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		msg    func() *SendVenueMessage
+		result error
+	}{
+		{
+			desc:   ErrIncorrectLatitude.Error(),
+			msg:    func() *SendVenueMessage { return &SendVenueMessage{ChatID: 1, Latitude: 91} },
+			result: ErrIncorrectLatitude,
+		},
+		{
+			desc:   ErrEmptyVenueTitle.Error(),
+			msg:    func() *SendVenueMessage { return &SendVenueMessage{ChatID: 1} },
+			result: ErrEmptyVenueTitle,
+		},
+		{
+			desc:   ErrEmptyVenueAddress.Error(),
+			msg:    func() *SendVenueMessage { return &SendVenueMessage{ChatID: 1, Title: "test"} },
+			result: ErrEmptyVenueAddress,
+		},
+		{
+			desc: "nil_result",
+			msg: func() *SendVenueMessage {
+				return &SendVenueMessage{ChatID: 1, Title: "test", Address: "test"}
+			},
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.msg().Validate(), test.result)
+		})
+	}
+}
+
+func Test_Client_SendLocation(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		body, _ := io.ReadAll(req.Body)
+
+		return string(body) == `{"chat_id":1,"latitude":55.7558,"longitude":-37.6173,`+
+			`"horizontal_accuracy":10.5,"live_period":60}`
+	})).Return(
+		&http.Response{
+			Body: io.NopCloser(bytes.NewBufferString(
+				`{"ok":true,"result":{"message_id":1,"location":{"latitude":55.7558,"longitude":-37.6173}}}`,
+			)),
+		},
+		nil,
+	)
+
+	client := new(Client)
+	client.http = httpClient
+
+	msg, err := client.SendLocation(context.Background(), 1, 55.7558, -37.6173,
+		HorizontalAccuracySendLocationOption(10.5),
+		LivePeriodSendLocationOption(60),
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &Location{Latitude: 55.7558, Longitude: -37.6173}, msg.Location)
+}
+
+func Test_EditMessageLiveLocation_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		msg    func() *EditMessageLiveLocation
+		result error
+	}{
+		{
+			desc:   ErrEmptyChatID.Error(),
+			msg:    func() *EditMessageLiveLocation { return &EditMessageLiveLocation{} },
+			result: ErrEmptyChatID,
+		},
+		{
+			desc:   ErrIncorrectMessageID.Error(),
+			msg:    func() *EditMessageLiveLocation { return &EditMessageLiveLocation{ChatID: 1, MessageID: -1} },
+			result: ErrIncorrectMessageID,
+		},
+		{
+			desc: ErrIncorrectLongitude.Error(),
+			msg: func() *EditMessageLiveLocation {
+				return &EditMessageLiveLocation{ChatID: 1, MessageID: 1, Longitude: -181}
+			},
+			result: ErrIncorrectLongitude,
+		},
+		{
+			desc: ErrIncorrectHeading.Error(),
+			msg: func() *EditMessageLiveLocation {
+				return &EditMessageLiveLocation{ChatID: 1, MessageID: 1, Heading: MaxHeading + 1}
+			},
+			result: ErrIncorrectHeading,
+		},
+		{
+			desc: "nil_result",
+			msg: func() *EditMessageLiveLocation {
+				return &EditMessageLiveLocation{ChatID: 1, MessageID: 1, Latitude: 1.5, Heading: MaxHeading}
+			},
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.msg().Validate(), test.result)
+		})
+	}
+}
+
+func Test_StopMessageLiveLocation_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		msg    func() *StopMessageLiveLocation
+		result error
+	}{
+		{
+			desc:   ErrEmptyChatID.Error(),
+			msg:    func() *StopMessageLiveLocation { return &StopMessageLiveLocation{} },
+			result: ErrEmptyChatID,
+		},
+		{
+			desc:   ErrIncorrectMessageID.Error(),
+			msg:    func() *StopMessageLiveLocation { return &StopMessageLiveLocation{ChatID: 1} },
+			result: ErrIncorrectMessageID,
+		},
+		{
+			desc:   "nil_result",
+			msg:    func() *StopMessageLiveLocation { return &StopMessageLiveLocation{ChatID: 1, MessageID: 1} },
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.msg().Validate(), test.result)
+		})
+	}
+}
+
+func Test_Client_EditMessageLiveLocation(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		body, _ := io.ReadAll(req.Body)
+
+		return string(body) == `{"chat_id":1,"message_id":2,"latitude":10.25,"longitude":20.5,"heading":90}`
+	})).Return(
+		&http.Response{
+			Body: io.NopCloser(bytes.NewBufferString(
+				`{"ok":true,"result":{"message_id":2,"location":{"latitude":10.25,"longitude":20.5}}}`,
+			)),
+		},
+		nil,
+	)
+
+	client := new(Client)
+	client.http = httpClient
+
+	msg, err := client.EditMessageLiveLocation(context.Background(), 1, 2, 10.25, 20.5,
+		HeadingEditLiveLocationOption(90),
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 10.25, msg.Location.Latitude)
+}
+
+func Test_Client_StopMessageLiveLocation_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	client := new(Client)
+	client.http = newMockHTTPClient(t)
+	client.chatLimiter = newChatLimiter(1, 1)
+	client.chatLimiter.reserve(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.StopMessageLiveLocation(ctx, 1, 2)
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
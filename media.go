@@ -0,0 +1,669 @@
+package tg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// MaxCaptionSize is the max caption size in UTF-16 code units (not bytes).
+const MaxCaptionSize int = 1024
+
+var ErrCaptionTooLong = errors.New("caption too long")
+
+// mediaBase is the set of fields shared by the media sending requests.
+type mediaBase struct {
+	MessageThreadID int64     `json:"message_thread_id,omitempty"`
+	Caption         string    `json:"caption,omitempty"`
+	ParseMode       ParseMode `json:"parse_mode,omitempty"`
+	HasSpoiler      bool      `json:"has_spoiler,omitempty"`
+}
+
+func (mb *mediaBase) Validate() error {
+	if err := validateMessageThreadID(mb.MessageThreadID); err != nil {
+		return err
+	}
+
+	if utf16Len(mb.Caption) > MaxCaptionSize {
+		return ErrCaptionTooLong
+	}
+
+	return mb.ParseMode.Validate()
+}
+
+// MediaOption is an option shared by all media sending methods.
+type MediaOption func(*mediaBase)
+
+func MessageThreadIDMediaOption(threadID int64) MediaOption {
+	return func(mb *mediaBase) {
+		mb.MessageThreadID = threadID
+	}
+}
+
+func CaptionOption(caption string) MediaOption {
+	return func(mb *mediaBase) {
+		mb.Caption = caption
+	}
+}
+
+func ParseModeMediaOption(mode ParseMode) MediaOption {
+	return func(mb *mediaBase) {
+		mb.ParseMode = mode
+	}
+}
+
+func HasSpoilerOption(spoiler bool) MediaOption {
+	return func(mb *mediaBase) {
+		mb.HasSpoiler = spoiler
+	}
+}
+
+type Video struct {
+	FileID       string `json:"file_id"`
+	FileUniqueID string `json:"file_unique_id"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+	Duration     int    `json:"duration"`
+	FileName     string `json:"file_name,omitempty"`
+	MimeType     string `json:"mime_type,omitempty"`
+	FileSize     int64  `json:"file_size,omitempty"`
+}
+
+type SendVideoMessage struct {
+	ChatID            int64      `json:"chat_id"`
+	Video             InputFile  `json:"video"`
+	Duration          int        `json:"duration,omitempty"`
+	Width             int        `json:"width,omitempty"`
+	Height            int        `json:"height,omitempty"`
+	Thumbnail         *InputFile `json:"thumbnail,omitempty"`
+	SupportsStreaming bool       `json:"supports_streaming,omitempty"`
+	mediaBase
+}
+
+var (
+	ErrEmptyVideo         = errors.New("empty video")
+	ErrIncorrectDuration  = errors.New("incorrect duration")
+	ErrIncorrectSize      = errors.New("incorrect width or height")
+	ErrThumbnailNotUpload = errors.New("thumbnail not upload")
+)
+
+func (sv *SendVideoMessage) Validate() error {
+	if sv.ChatID == 0 {
+		return ErrEmptyChatID
+	}
+
+	if sv.Video.IsZero() {
+		return ErrEmptyVideo
+	}
+
+	if sv.Thumbnail != nil && !sv.Thumbnail.IsUpload() {
+		return ErrThumbnailNotUpload
+	}
+
+	if sv.Duration < 0 {
+		return ErrIncorrectDuration
+	}
+
+	if sv.Width < 0 || sv.Height < 0 {
+		return ErrIncorrectSize
+	}
+
+	return sv.mediaBase.Validate()
+}
+
+// SendVideoOption is implemented by the sendVideo specific options and by MediaOption.
+type SendVideoOption interface {
+	applySendVideo(sv *SendVideoMessage)
+}
+
+func (o MediaOption) applySendVideo(sv *SendVideoMessage) {
+	o(&sv.mediaBase)
+}
+
+type sendVideoOption func(*SendVideoMessage)
+
+func (o sendVideoOption) applySendVideo(sv *SendVideoMessage) {
+	o(sv)
+}
+
+func NewSendVideoMessage(chatID int64, video InputFile, opts ...SendVideoOption) (*SendVideoMessage, error) {
+	sv := new(SendVideoMessage)
+
+	sv.Video = video
+
+	for _, opt := range opts {
+		opt.applySendVideo(sv)
+	}
+
+	sv.ChatID = chatID
+
+	if err := sv.Validate(); err != nil {
+		return nil, fmt.Errorf("SendVideoMessage: %w", err)
+	}
+
+	return sv, nil
+}
+
+// ThumbnailSendVideoOption sets the video thumbnail, it can only be uploaded (FileFromReader).
+func ThumbnailSendVideoOption(thumbnail InputFile) SendVideoOption {
+	return sendVideoOption(func(sv *SendVideoMessage) {
+		sv.Thumbnail = &thumbnail
+	})
+}
+
+func DurationSendVideoOption(duration int) SendVideoOption {
+	return sendVideoOption(func(sv *SendVideoMessage) {
+		sv.Duration = duration
+	})
+}
+
+func SizeSendVideoOption(width, height int) SendVideoOption {
+	return sendVideoOption(func(sv *SendVideoMessage) {
+		sv.Width = width
+		sv.Height = height
+	})
+}
+
+func SupportsStreamingSendVideoOption(supports bool) SendVideoOption {
+	return sendVideoOption(func(sv *SendVideoMessage) {
+		sv.SupportsStreaming = supports
+	})
+}
+
+const sendVideoMethod = "sendVideo"
+
+func (c *Client) SendVideo(ctx context.Context,
+	chatID int64, video InputFile, opts ...SendVideoOption,
+) (*Message, error) {
+	req, err := NewSendVideoMessage(chatID, video, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("SendVideo: %w", err)
+	}
+
+	if err := c.allowChat(chatID); err != nil {
+		return nil, fmt.Errorf("SendVideo: %w", err)
+	}
+
+	if err := c.waitChat(ctx, chatID); err != nil {
+		return nil, fmt.Errorf("SendVideo: %w", err)
+	}
+
+	resp := new(Message)
+
+	if err := c.API(ctx, sendVideoMethod, req, resp); err != nil {
+		return nil, fmt.Errorf("SendVideo: %w", err)
+	}
+
+	return resp, nil
+}
+
+type Voice struct {
+	FileID       string `json:"file_id"`
+	FileUniqueID string `json:"file_unique_id"`
+	Duration     int    `json:"duration"`
+	MimeType     string `json:"mime_type,omitempty"`
+	FileSize     int64  `json:"file_size,omitempty"`
+}
+
+// SendVoiceMessage is the sendVoice request, the voice must be in an .ogg file encoded with OPUS.
+type SendVoiceMessage struct {
+	ChatID   int64     `json:"chat_id"`
+	Voice    InputFile `json:"voice"`
+	Duration int       `json:"duration,omitempty"`
+	mediaBase
+}
+
+var ErrEmptyVoice = errors.New("empty voice")
+
+func (sv *SendVoiceMessage) Validate() error {
+	if sv.ChatID == 0 {
+		return ErrEmptyChatID
+	}
+
+	if sv.Voice.IsZero() {
+		return ErrEmptyVoice
+	}
+
+	if sv.Duration < 0 {
+		return ErrIncorrectDuration
+	}
+
+	return sv.mediaBase.Validate()
+}
+
+// SendVoiceOption is implemented by the sendVoice specific options and by MediaOption.
+type SendVoiceOption interface {
+	applySendVoice(sv *SendVoiceMessage)
+}
+
+func (o MediaOption) applySendVoice(sv *SendVoiceMessage) {
+	o(&sv.mediaBase)
+}
+
+type sendVoiceOption func(*SendVoiceMessage)
+
+func (o sendVoiceOption) applySendVoice(sv *SendVoiceMessage) {
+	o(sv)
+}
+
+func NewSendVoiceMessage(chatID int64, voice InputFile, opts ...SendVoiceOption) (*SendVoiceMessage, error) {
+	sv := new(SendVoiceMessage)
+
+	sv.Voice = voice
+
+	for _, opt := range opts {
+		opt.applySendVoice(sv)
+	}
+
+	sv.ChatID = chatID
+
+	if err := sv.Validate(); err != nil {
+		return nil, fmt.Errorf("SendVoiceMessage: %w", err)
+	}
+
+	return sv, nil
+}
+
+func DurationSendVoiceOption(duration int) SendVoiceOption {
+	return sendVoiceOption(func(sv *SendVoiceMessage) {
+		sv.Duration = duration
+	})
+}
+
+const sendVoiceMethod = "sendVoice"
+
+func (c *Client) SendVoice(ctx context.Context,
+	chatID int64, voice InputFile, opts ...SendVoiceOption,
+) (*Message, error) {
+	req, err := NewSendVoiceMessage(chatID, voice, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("SendVoice: %w", err)
+	}
+
+	if err := c.allowChat(chatID); err != nil {
+		return nil, fmt.Errorf("SendVoice: %w", err)
+	}
+
+	if err := c.waitChat(ctx, chatID); err != nil {
+		return nil, fmt.Errorf("SendVoice: %w", err)
+	}
+
+	resp := new(Message)
+
+	if err := c.API(ctx, sendVoiceMethod, req, resp); err != nil {
+		return nil, fmt.Errorf("SendVoice: %w", err)
+	}
+
+	return resp, nil
+}
+
+type Audio struct {
+	FileID       string `json:"file_id"`
+	FileUniqueID string `json:"file_unique_id"`
+	Duration     int    `json:"duration"`
+	Performer    string `json:"performer,omitempty"`
+	Title        string `json:"title,omitempty"`
+	FileName     string `json:"file_name,omitempty"`
+	MimeType     string `json:"mime_type,omitempty"`
+	FileSize     int64  `json:"file_size,omitempty"`
+}
+
+// SendAudioMessage is the sendAudio request, the audio must be in the .MP3 or .M4A format.
+type SendAudioMessage struct {
+	ChatID    int64      `json:"chat_id"`
+	Audio     InputFile  `json:"audio"`
+	Duration  int        `json:"duration,omitempty"`
+	Performer string     `json:"performer,omitempty"`
+	Title     string     `json:"title,omitempty"`
+	Thumbnail *InputFile `json:"thumbnail,omitempty"`
+	mediaBase
+}
+
+var ErrEmptyAudio = errors.New("empty audio")
+
+func (sa *SendAudioMessage) Validate() error {
+	if sa.ChatID == 0 {
+		return ErrEmptyChatID
+	}
+
+	if sa.Audio.IsZero() {
+		return ErrEmptyAudio
+	}
+
+	if sa.Thumbnail != nil && !sa.Thumbnail.IsUpload() {
+		return ErrThumbnailNotUpload
+	}
+
+	if sa.Duration < 0 {
+		return ErrIncorrectDuration
+	}
+
+	return sa.mediaBase.Validate()
+}
+
+// SendAudioOption is implemented by the sendAudio specific options and by MediaOption.
+type SendAudioOption interface {
+	applySendAudio(sa *SendAudioMessage)
+}
+
+func (o MediaOption) applySendAudio(sa *SendAudioMessage) {
+	o(&sa.mediaBase)
+}
+
+type sendAudioOption func(*SendAudioMessage)
+
+func (o sendAudioOption) applySendAudio(sa *SendAudioMessage) {
+	o(sa)
+}
+
+func NewSendAudioMessage(chatID int64, audio InputFile, opts ...SendAudioOption) (*SendAudioMessage, error) {
+	sa := new(SendAudioMessage)
+
+	sa.Audio = audio
+
+	for _, opt := range opts {
+		opt.applySendAudio(sa)
+	}
+
+	sa.ChatID = chatID
+
+	if err := sa.Validate(); err != nil {
+		return nil, fmt.Errorf("SendAudioMessage: %w", err)
+	}
+
+	return sa, nil
+}
+
+func DurationSendAudioOption(duration int) SendAudioOption {
+	return sendAudioOption(func(sa *SendAudioMessage) {
+		sa.Duration = duration
+	})
+}
+
+func PerformerSendAudioOption(performer string) SendAudioOption {
+	return sendAudioOption(func(sa *SendAudioMessage) {
+		sa.Performer = performer
+	})
+}
+
+func TitleSendAudioOption(title string) SendAudioOption {
+	return sendAudioOption(func(sa *SendAudioMessage) {
+		sa.Title = title
+	})
+}
+
+// ThumbnailSendAudioOption sets the audio thumbnail, it can only be uploaded (FileFromReader).
+func ThumbnailSendAudioOption(thumbnail InputFile) SendAudioOption {
+	return sendAudioOption(func(sa *SendAudioMessage) {
+		sa.Thumbnail = &thumbnail
+	})
+}
+
+const sendAudioMethod = "sendAudio"
+
+func (c *Client) SendAudio(ctx context.Context,
+	chatID int64, audio InputFile, opts ...SendAudioOption,
+) (*Message, error) {
+	req, err := NewSendAudioMessage(chatID, audio, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("SendAudio: %w", err)
+	}
+
+	if err := c.allowChat(chatID); err != nil {
+		return nil, fmt.Errorf("SendAudio: %w", err)
+	}
+
+	if err := c.waitChat(ctx, chatID); err != nil {
+		return nil, fmt.Errorf("SendAudio: %w", err)
+	}
+
+	resp := new(Message)
+
+	if err := c.API(ctx, sendAudioMethod, req, resp); err != nil {
+		return nil, fmt.Errorf("SendAudio: %w", err)
+	}
+
+	return resp, nil
+}
+
+type Animation struct {
+	FileID       string `json:"file_id"`
+	FileUniqueID string `json:"file_unique_id"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+	Duration     int    `json:"duration"`
+	FileName     string `json:"file_name,omitempty"`
+	MimeType     string `json:"mime_type,omitempty"`
+	FileSize     int64  `json:"file_size,omitempty"`
+}
+
+// SendAnimationMessage is the sendAnimation request, the animation is a GIF
+// or a H.264/MPEG-4 AVC video without sound.
+type SendAnimationMessage struct {
+	ChatID    int64      `json:"chat_id"`
+	Animation InputFile  `json:"animation"`
+	Duration  int        `json:"duration,omitempty"`
+	Width     int        `json:"width,omitempty"`
+	Height    int        `json:"height,omitempty"`
+	Thumbnail *InputFile `json:"thumbnail,omitempty"`
+	mediaBase
+}
+
+var ErrEmptyAnimation = errors.New("empty animation")
+
+func (sa *SendAnimationMessage) Validate() error {
+	if sa.ChatID == 0 {
+		return ErrEmptyChatID
+	}
+
+	if sa.Animation.IsZero() {
+		return ErrEmptyAnimation
+	}
+
+	if sa.Thumbnail != nil && !sa.Thumbnail.IsUpload() {
+		return ErrThumbnailNotUpload
+	}
+
+	if sa.Duration < 0 {
+		return ErrIncorrectDuration
+	}
+
+	if sa.Width < 0 || sa.Height < 0 {
+		return ErrIncorrectSize
+	}
+
+	return sa.mediaBase.Validate()
+}
+
+// SendAnimationOption is implemented by the sendAnimation specific options and by MediaOption.
+type SendAnimationOption interface {
+	applySendAnimation(sa *SendAnimationMessage)
+}
+
+func (o MediaOption) applySendAnimation(sa *SendAnimationMessage) {
+	o(&sa.mediaBase)
+}
+
+type sendAnimationOption func(*SendAnimationMessage)
+
+func (o sendAnimationOption) applySendAnimation(sa *SendAnimationMessage) {
+	o(sa)
+}
+
+func NewSendAnimationMessage(chatID int64,
+	animation InputFile, opts ...SendAnimationOption,
+) (*SendAnimationMessage, error) {
+	sa := new(SendAnimationMessage)
+
+	sa.Animation = animation
+
+	for _, opt := range opts {
+		opt.applySendAnimation(sa)
+	}
+
+	sa.ChatID = chatID
+
+	if err := sa.Validate(); err != nil {
+		return nil, fmt.Errorf("SendAnimationMessage: %w", err)
+	}
+
+	return sa, nil
+}
+
+// ThumbnailSendAnimationOption sets the animation thumbnail, it can only be uploaded (FileFromReader).
+func ThumbnailSendAnimationOption(thumbnail InputFile) SendAnimationOption {
+	return sendAnimationOption(func(sa *SendAnimationMessage) {
+		sa.Thumbnail = &thumbnail
+	})
+}
+
+func DurationSendAnimationOption(duration int) SendAnimationOption {
+	return sendAnimationOption(func(sa *SendAnimationMessage) {
+		sa.Duration = duration
+	})
+}
+
+func SizeSendAnimationOption(width, height int) SendAnimationOption {
+	return sendAnimationOption(func(sa *SendAnimationMessage) {
+		sa.Width = width
+		sa.Height = height
+	})
+}
+
+const sendAnimationMethod = "sendAnimation"
+
+func (c *Client) SendAnimation(ctx context.Context,
+	chatID int64, animation InputFile, opts ...SendAnimationOption,
+) (*Message, error) {
+	req, err := NewSendAnimationMessage(chatID, animation, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("SendAnimation: %w", err)
+	}
+
+	if err := c.allowChat(chatID); err != nil {
+		return nil, fmt.Errorf("SendAnimation: %w", err)
+	}
+
+	if err := c.waitChat(ctx, chatID); err != nil {
+		return nil, fmt.Errorf("SendAnimation: %w", err)
+	}
+
+	resp := new(Message)
+
+	if err := c.API(ctx, sendAnimationMethod, req, resp); err != nil {
+		return nil, fmt.Errorf("SendAnimation: %w", err)
+	}
+
+	return resp, nil
+}
+
+type Document struct {
+	FileID       string `json:"file_id"`
+	FileUniqueID string `json:"file_unique_id"`
+	FileName     string `json:"file_name,omitempty"`
+	MimeType     string `json:"mime_type,omitempty"`
+	FileSize     int64  `json:"file_size,omitempty"`
+}
+
+// SendDocumentMessage is the sendDocument request, an uploaded document
+// (FileFromReader) is streamed to the server without buffering it in memory.
+type SendDocumentMessage struct {
+	ChatID                      int64      `json:"chat_id"`
+	Document                    InputFile  `json:"document"`
+	Thumbnail                   *InputFile `json:"thumbnail,omitempty"`
+	DisableContentTypeDetection bool       `json:"disable_content_type_detection,omitempty"`
+	mediaBase
+}
+
+var ErrEmptyDocument = errors.New("empty document")
+
+func (sd *SendDocumentMessage) Validate() error {
+	if sd.ChatID == 0 {
+		return ErrEmptyChatID
+	}
+
+	if sd.Document.IsZero() {
+		return ErrEmptyDocument
+	}
+
+	if sd.Thumbnail != nil && !sd.Thumbnail.IsUpload() {
+		return ErrThumbnailNotUpload
+	}
+
+	return sd.mediaBase.Validate()
+}
+
+// SendDocumentOption is implemented by the sendDocument specific options and by MediaOption.
+type SendDocumentOption interface {
+	applySendDocument(sd *SendDocumentMessage)
+}
+
+func (o MediaOption) applySendDocument(sd *SendDocumentMessage) {
+	o(&sd.mediaBase)
+}
+
+type sendDocumentOption func(*SendDocumentMessage)
+
+func (o sendDocumentOption) applySendDocument(sd *SendDocumentMessage) {
+	o(sd)
+}
+
+func NewSendDocumentMessage(chatID int64,
+	document InputFile, opts ...SendDocumentOption,
+) (*SendDocumentMessage, error) {
+	sd := new(SendDocumentMessage)
+
+	sd.Document = document
+
+	for _, opt := range opts {
+		opt.applySendDocument(sd)
+	}
+
+	sd.ChatID = chatID
+
+	if err := sd.Validate(); err != nil {
+		return nil, fmt.Errorf("SendDocumentMessage: %w", err)
+	}
+
+	return sd, nil
+}
+
+// ThumbnailSendDocumentOption sets the document thumbnail, it can only be uploaded (FileFromReader).
+func ThumbnailSendDocumentOption(thumbnail InputFile) SendDocumentOption {
+	return sendDocumentOption(func(sd *SendDocumentMessage) {
+		sd.Thumbnail = &thumbnail
+	})
+}
+
+func DisableContentTypeDetectionSendDocumentOption(disable bool) SendDocumentOption {
+	return sendDocumentOption(func(sd *SendDocumentMessage) {
+		sd.DisableContentTypeDetection = disable
+	})
+}
+
+const sendDocumentMethod = "sendDocument"
+
+func (c *Client) SendDocument(ctx context.Context,
+	chatID int64, document InputFile, opts ...SendDocumentOption,
+) (*Message, error) {
+	req, err := NewSendDocumentMessage(chatID, document, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("SendDocument: %w", err)
+	}
+
+	if err := c.allowChat(chatID); err != nil {
+		return nil, fmt.Errorf("SendDocument: %w", err)
+	}
+
+	if err := c.waitChat(ctx, chatID); err != nil {
+		return nil, fmt.Errorf("SendDocument: %w", err)
+	}
+
+	resp := new(Message)
+
+	if err := c.API(ctx, sendDocumentMethod, req, resp); err != nil {
+		return nil, fmt.Errorf("SendDocument: %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,646 @@
+//nolint:exhaustruct
+package tg
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_SendVideoMessage_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		msg    func() *SendVideoMessage
+		result error
+	}{
+		{
+			desc:   ErrEmptyChatID.Error(),
+			msg:    func() *SendVideoMessage { return &SendVideoMessage{} },
+			result: ErrEmptyChatID,
+		},
+		{
+			desc:   ErrEmptyVideo.Error(),
+			msg:    func() *SendVideoMessage { return &SendVideoMessage{ChatID: 1} },
+			result: ErrEmptyVideo,
+		},
+		{
+			desc: ErrThumbnailNotUpload.Error(),
+			msg: func() *SendVideoMessage {
+				thumbnail := FileFromID("test")
+
+				return &SendVideoMessage{
+					ChatID:    1,
+					Video:     FileFromID("test"),
+					Thumbnail: &thumbnail,
+				}
+			},
+			result: ErrThumbnailNotUpload,
+		},
+		{
+			desc: ErrIncorrectDuration.Error(),
+			msg: func() *SendVideoMessage {
+				return &SendVideoMessage{
+					ChatID:   1,
+					Video:    FileFromID("test"),
+					Duration: -1,
+				}
+			},
+			result: ErrIncorrectDuration,
+		},
+		{
+			desc: ErrIncorrectSize.Error(),
+			msg: func() *SendVideoMessage {
+				return &SendVideoMessage{
+					ChatID: 1,
+					Video:  FileFromID("test"),
+					Width:  -1,
+				}
+			},
+			result: ErrIncorrectSize,
+		},
+		{
+			desc: ErrCaptionTooLong.Error(),
+			msg: func() *SendVideoMessage {
+				return &SendVideoMessage{
+					ChatID: 1,
+					Video:  FileFromID("test"),
+					mediaBase: mediaBase{
+						Caption: strings.Repeat("😀", MaxCaptionSize/2+1),
+					},
+				}
+			},
+			result: ErrCaptionTooLong,
+		},
+		{
+			desc: ErrUnknownParseMode.Error(),
+			msg: func() *SendVideoMessage {
+				return &SendVideoMessage{
+					ChatID: 1,
+					Video:  FileFromID("test"),
+					mediaBase: mediaBase{
+						ParseMode: testBadParseMode,
+					},
+				}
+			},
+			result: ErrUnknownParseMode,
+		},
+		{
+			desc: "nil_result",
+			msg: func() *SendVideoMessage {
+				return &SendVideoMessage{
+					ChatID: 1,
+					Video:  FileFromID("test"),
+					mediaBase: mediaBase{
+						Caption: strings.Repeat("😀", MaxCaptionSize/2),
+					},
+				}
+			},
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.msg().Validate(), test.result)
+		})
+	}
+}
+
+func Test_Client_SendVideo_Upload(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		if err := req.ParseMultipartForm(1 << 20); err != nil {
+			return false
+		}
+
+		file, _, err := req.FormFile("video")
+		if err != nil {
+			return false
+		}
+
+		data, _ := io.ReadAll(file)
+
+		if _, _, err := req.FormFile("thumbnail"); err != nil {
+			return false
+		}
+
+		return req.FormValue("chat_id") == "1" &&
+			req.FormValue("caption") == "test" &&
+			req.FormValue("has_spoiler") == "true" &&
+			req.FormValue("video") == "" &&
+			string(data) == "video"
+	})).Return(
+		&http.Response{
+			Body: io.NopCloser(bytes.NewBufferString(
+				`{"ok":true,"result":{"message_id":1,"video":{"file_id":"test"}}}`,
+			)),
+		},
+		nil,
+	)
+
+	client := new(Client)
+	client.http = httpClient
+
+	msg, err := client.SendVideo(context.Background(), 1,
+		FileFromReader("video.mp4", bytes.NewBufferString("video")),
+		ThumbnailSendVideoOption(FileFromReader("thumb.jpg", bytes.NewBufferString("thumb"))),
+		CaptionOption("test"),
+		HasSpoilerOption(true),
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "test", msg.Video.FileID)
+}
+
+func Test_SendVoiceMessage_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		msg    func() *SendVoiceMessage
+		result error
+	}{
+		{
+			desc:   ErrEmptyChatID.Error(),
+			msg:    func() *SendVoiceMessage { return &SendVoiceMessage{} },
+			result: ErrEmptyChatID,
+		},
+		{
+			desc:   ErrEmptyVoice.Error(),
+			msg:    func() *SendVoiceMessage { return &SendVoiceMessage{ChatID: 1} },
+			result: ErrEmptyVoice,
+		},
+		{
+			desc: ErrIncorrectDuration.Error(),
+			msg: func() *SendVoiceMessage {
+				return &SendVoiceMessage{ChatID: 1, Voice: FileFromID("test"), Duration: -1}
+			},
+			result: ErrIncorrectDuration,
+		},
+		{
+			desc: ErrCaptionTooLong.Error(),
+			msg: func() *SendVoiceMessage {
+				return &SendVoiceMessage{
+					ChatID:    1,
+					Voice:     FileFromID("test"),
+					mediaBase: mediaBase{Caption: strings.Repeat("a", MaxCaptionSize+1)},
+				}
+			},
+			result: ErrCaptionTooLong,
+		},
+		{
+			desc: "nil_result",
+			msg: func() *SendVoiceMessage {
+				return &SendVoiceMessage{ChatID: 1, Voice: FileFromURL("https://example.com/voice.ogg")}
+			},
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.msg().Validate(), test.result)
+		})
+	}
+}
+
+func Test_SendAudioMessage_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		msg    func() *SendAudioMessage
+		result error
+	}{
+		{
+			desc:   ErrEmptyChatID.Error(),
+			msg:    func() *SendAudioMessage { return &SendAudioMessage{} },
+			result: ErrEmptyChatID,
+		},
+		{
+			desc:   ErrEmptyAudio.Error(),
+			msg:    func() *SendAudioMessage { return &SendAudioMessage{ChatID: 1} },
+			result: ErrEmptyAudio,
+		},
+		{
+			desc: ErrThumbnailNotUpload.Error(),
+			msg: func() *SendAudioMessage {
+				thumbnail := FileFromID("test")
+
+				return &SendAudioMessage{ChatID: 1, Audio: FileFromID("test"), Thumbnail: &thumbnail}
+			},
+			result: ErrThumbnailNotUpload,
+		},
+		{
+			desc: ErrIncorrectDuration.Error(),
+			msg: func() *SendAudioMessage {
+				return &SendAudioMessage{ChatID: 1, Audio: FileFromID("test"), Duration: -1}
+			},
+			result: ErrIncorrectDuration,
+		},
+		{
+			desc: "nil_result",
+			msg: func() *SendAudioMessage {
+				return &SendAudioMessage{ChatID: 1, Audio: FileFromID("test"), Title: "test"}
+			},
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.msg().Validate(), test.result)
+		})
+	}
+}
+
+func Test_Client_SendAudio(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		body, _ := io.ReadAll(req.Body)
+
+		return string(body) == `{"chat_id":1,"audio":"test","duration":60,"performer":"performer",`+
+			`"title":"title","caption":"caption"}`
+	})).Return(
+		&http.Response{
+			Body: io.NopCloser(bytes.NewBufferString(
+				`{"ok":true,"result":{"message_id":1,"audio":{"file_id":"test","duration":60}}}`,
+			)),
+		},
+		nil,
+	)
+
+	client := new(Client)
+	client.http = httpClient
+
+	msg, err := client.SendAudio(context.Background(), 1, FileFromID("test"),
+		DurationSendAudioOption(60),
+		PerformerSendAudioOption("performer"),
+		TitleSendAudioOption("title"),
+		CaptionOption("caption"),
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 60, msg.Audio.Duration)
+}
+
+func Test_Client_SendVoice(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		if err := req.ParseMultipartForm(1 << 20); err != nil {
+			return false
+		}
+
+		_, header, err := req.FormFile("voice")
+
+		return err == nil && header.Filename == "voice.ogg" &&
+			req.FormValue("duration") == "5"
+	})).Return(
+		&http.Response{
+			Body: io.NopCloser(bytes.NewBufferString(
+				`{"ok":true,"result":{"message_id":1,"voice":{"file_id":"test","duration":5}}}`,
+			)),
+		},
+		nil,
+	)
+
+	client := new(Client)
+	client.http = httpClient
+
+	msg, err := client.SendVoice(context.Background(), 1,
+		FileFromReader("voice.ogg", bytes.NewBufferString("voice")),
+		DurationSendVoiceOption(5),
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "test", msg.Voice.FileID)
+}
+
+func Test_SendAnimationMessage_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		msg    func() *SendAnimationMessage
+		result error
+	}{
+		{
+			desc:   ErrEmptyChatID.Error(),
+			msg:    func() *SendAnimationMessage { return &SendAnimationMessage{} },
+			result: ErrEmptyChatID,
+		},
+		{
+			desc:   ErrEmptyAnimation.Error(),
+			msg:    func() *SendAnimationMessage { return &SendAnimationMessage{ChatID: 1} },
+			result: ErrEmptyAnimation,
+		},
+		{
+			desc: ErrThumbnailNotUpload.Error(),
+			msg: func() *SendAnimationMessage {
+				thumbnail := FileFromID("test")
+
+				return &SendAnimationMessage{ChatID: 1, Animation: FileFromID("test"), Thumbnail: &thumbnail}
+			},
+			result: ErrThumbnailNotUpload,
+		},
+		{
+			desc: ErrIncorrectDuration.Error(),
+			msg: func() *SendAnimationMessage {
+				return &SendAnimationMessage{ChatID: 1, Animation: FileFromID("test"), Duration: -1}
+			},
+			result: ErrIncorrectDuration,
+		},
+		{
+			desc: ErrIncorrectSize.Error(),
+			msg: func() *SendAnimationMessage {
+				return &SendAnimationMessage{ChatID: 1, Animation: FileFromID("test"), Height: -1}
+			},
+			result: ErrIncorrectSize,
+		},
+		{
+			desc: ErrCaptionTooLong.Error(),
+			msg: func() *SendAnimationMessage {
+				return &SendAnimationMessage{
+					ChatID:    1,
+					Animation: FileFromID("test"),
+					mediaBase: mediaBase{Caption: strings.Repeat("a", MaxCaptionSize+1)},
+				}
+			},
+			result: ErrCaptionTooLong,
+		},
+		{
+			desc: "nil_result",
+			msg: func() *SendAnimationMessage {
+				return &SendAnimationMessage{ChatID: 1, Animation: FileFromURL("https://example.com/loading.gif")}
+			},
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.msg().Validate(), test.result)
+		})
+	}
+}
+
+func Test_Client_SendAnimation(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		body, _ := io.ReadAll(req.Body)
+
+		return string(body) == `{"chat_id":1,"animation":"test","duration":3,"width":320,"height":240,`+
+			`"caption":"caption","has_spoiler":true}`
+	})).Return(
+		&http.Response{
+			Body: io.NopCloser(bytes.NewBufferString(
+				`{"ok":true,"result":{"message_id":1,"animation":{"file_id":"test","width":320,"height":240,` +
+					`"duration":3}}}`,
+			)),
+		},
+		nil,
+	)
+
+	client := new(Client)
+	client.http = httpClient
+
+	msg, err := client.SendAnimation(context.Background(), 1, FileFromID("test"),
+		DurationSendAnimationOption(3),
+		SizeSendAnimationOption(320, 240),
+		CaptionOption("caption"),
+		HasSpoilerOption(true),
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, msg.Animation.Duration)
+}
+
+func Test_SendDocumentMessage_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		msg    func() *SendDocumentMessage
+		result error
+	}{
+		{
+			desc:   ErrEmptyChatID.Error(),
+			msg:    func() *SendDocumentMessage { return &SendDocumentMessage{} },
+			result: ErrEmptyChatID,
+		},
+		{
+			desc:   ErrEmptyDocument.Error(),
+			msg:    func() *SendDocumentMessage { return &SendDocumentMessage{ChatID: 1} },
+			result: ErrEmptyDocument,
+		},
+		{
+			desc: ErrThumbnailNotUpload.Error(),
+			msg: func() *SendDocumentMessage {
+				thumbnail := FileFromID("test")
+
+				return &SendDocumentMessage{ChatID: 1, Document: FileFromID("test"), Thumbnail: &thumbnail}
+			},
+			result: ErrThumbnailNotUpload,
+		},
+		{
+			desc: "nil_result",
+			msg: func() *SendDocumentMessage {
+				return &SendDocumentMessage{ChatID: 1, Document: FileFromID("test")}
+			},
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.msg().Validate(), test.result)
+		})
+	}
+}
+
+// repeatReader is an endless reader of the same byte.
+type repeatReader byte
+
+func (r repeatReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = byte(r)
+	}
+
+	return len(p), nil
+}
+
+// errorReader returns err after size bytes.
+type errorReader struct {
+	size int
+	err  error
+}
+
+func (r *errorReader) Read(p []byte) (int, error) {
+	if r.size == 0 {
+		return 0, r.err
+	}
+
+	n := min(len(p), r.size)
+	r.size -= n
+
+	return n, nil
+}
+
+// gateReader returns half of the file, then blocks until the gate is open,
+// so the file can be sent whole only if the request is streamed.
+type gateReader struct {
+	size int
+	read int
+	gate <-chan struct{}
+}
+
+var errNotStreamed = errors.New("not streamed")
+
+func (r *gateReader) Read(p []byte) (int, error) {
+	if r.read == r.size/2 {
+		select {
+		case <-r.gate:
+		case <-time.After(5 * time.Second):
+			return 0, errNotStreamed
+		}
+	}
+
+	if r.read == r.size {
+		return 0, io.EOF
+	}
+
+	limit := r.size - r.read
+	if r.read < r.size/2 {
+		limit = r.size/2 - r.read
+	}
+
+	n := min(len(p), limit)
+
+	for i := range n {
+		p[i] = 'a'
+	}
+
+	r.read += n
+
+	return n, nil
+}
+
+func Test_Client_SendDocument_Stream(t *testing.T) {
+	t.Parallel()
+
+	const size = 64 << 10
+
+	gate := make(chan struct{})
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.Anything).Return(func(req *http.Request) (*http.Response, error) {
+		// the file is still being read when the request is sent
+		close(gate)
+
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		assert.True(t, strings.HasPrefix(req.Header.Get("Content-Type"), "multipart/form-data"))
+		assert.Contains(t, string(body), strings.Repeat("a", size))
+
+		return &http.Response{
+			Body: io.NopCloser(bytes.NewBufferString(
+				`{"ok":true,"result":{"message_id":1,"document":{"file_id":"test"}}}`,
+			)),
+		}, nil
+	})
+
+	client := new(Client)
+	client.http = httpClient
+
+	msg, err := client.SendDocument(context.Background(), 1,
+		FileFromReader("big.bin", &gateReader{size: size, gate: gate}),
+		CaptionOption("caption"),
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "test", msg.Document.FileID)
+}
+
+func Test_Client_SendDocument_ReaderError(t *testing.T) {
+	t.Parallel()
+
+	errRead := errors.New("read")
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.Anything).Return(
+		func(req *http.Request) (*http.Response, error) {
+			_, err := io.Copy(io.Discard, req.Body)
+
+			return nil, err
+		},
+	)
+
+	client := new(Client)
+	client.http = httpClient
+
+	_, err := client.SendDocument(context.Background(), 1,
+		FileFromReader("big.bin", &errorReader{size: 1 << 20, err: errRead}),
+	)
+
+	assert.ErrorIs(t, err, errRead)
+}
+
+//nolint:paralleltest
+func Test_Client_SendDocument_NoLeak(t *testing.T) {
+	errDo := errors.New("do")
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.Anything).Return(nil, errDo)
+
+	client := new(Client)
+	client.http = httpClient
+
+	goroutines := runtime.NumGoroutine()
+
+	_, err := client.SendDocument(context.Background(), 1,
+		FileFromReader("big.bin", io.LimitReader(repeatReader('a'), 1<<20)),
+	)
+
+	assert.ErrorIs(t, err, errDo)
+
+	// the writing goroutine ends once the pipe is closed
+	for deadline := time.Now().Add(time.Second); runtime.NumGoroutine() > goroutines; {
+		if time.Now().After(deadline) {
+			t.Fatalf("goroutines: %d > %d", runtime.NumGoroutine(), goroutines)
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+}
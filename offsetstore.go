@@ -0,0 +1,97 @@
+package tg
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// OffsetStore keeps the offset of the next update to receive,
+// so Poll can resume after a restart without reprocessing the old updates.
+type OffsetStore interface {
+	Load() (int64, error)
+	Save(offset int64) error
+}
+
+// MemoryOffsetStore is the default OffsetStore, it doesn't survive a restart.
+type MemoryOffsetStore struct {
+	mu     sync.Mutex
+	offset int64
+}
+
+var _ OffsetStore = (*MemoryOffsetStore)(nil)
+
+func (s *MemoryOffsetStore) Load() (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.offset, nil
+}
+
+func (s *MemoryOffsetStore) Save(offset int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.offset = offset
+
+	return nil
+}
+
+// FileOffsetStore keeps the offset in a file, which is replaced atomically on Save.
+type FileOffsetStore struct {
+	path string
+}
+
+var _ OffsetStore = (*FileOffsetStore)(nil)
+
+func NewFileOffsetStore(path string) *FileOffsetStore {
+	return &FileOffsetStore{path: path}
+}
+
+// Load returns 0 if the file doesn't exist yet.
+func (s *FileOffsetStore) Load() (int64, error) {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, nil
+		}
+
+		return 0, fmt.Errorf("offset store: %w", err)
+	}
+
+	offset, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("offset store: %w", err)
+	}
+
+	return offset, nil
+}
+
+func (s *FileOffsetStore) Save(offset int64) error {
+	file, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return fmt.Errorf("offset store: %w", err)
+	}
+
+	defer os.Remove(file.Name())
+
+	if _, err := file.WriteString(strconv.FormatInt(offset, 10) + "\n"); err != nil {
+		file.Close()
+
+		return fmt.Errorf("offset store: %w", err)
+	}
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("offset store: %w", err)
+	}
+
+	if err := os.Rename(file.Name(), s.path); err != nil {
+		return fmt.Errorf("offset store: %w", err)
+	}
+
+	return nil
+}
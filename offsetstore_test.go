@@ -0,0 +1,81 @@
+//nolint:exhaustruct
+package tg
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_FileOffsetStore(t *testing.T) {
+	t.Parallel()
+
+	store := NewFileOffsetStore(filepath.Join(t.TempDir(), "offset"))
+
+	offset, err := store.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), offset)
+
+	assert.NoError(t, store.Save(10))
+	assert.NoError(t, store.Save(11))
+
+	offset, err = store.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(11), offset)
+}
+
+func Test_FileOffsetStore_Load_Bad(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "offset")
+
+	assert.NoError(t, os.WriteFile(path, []byte("test"), 0o600))
+
+	_, err := NewFileOffsetStore(path).Load()
+	assert.Error(t, err)
+}
+
+func Test_Client_Poll_OffsetStore(t *testing.T) {
+	t.Parallel()
+
+	updates := []Update{{UpdateID: 10}, {UpdateID: 11}, {UpdateID: 12}}
+	offsets := make([]int64, 0)
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.Anything).Return(updatesServer(t, updates, &offsets))
+
+	client := new(Client)
+	client.http = httpClient
+
+	store := new(MemoryOffsetStore)
+	assert.NoError(t, store.Save(11))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	handled := make([]int64, 0)
+
+	err := client.Poll(ctx, func(_ context.Context, update Update) error {
+		handled = append(handled, update.UpdateID)
+
+		if update.UpdateID == 12 {
+			cancel()
+		}
+
+		return nil
+	}, OffsetStorePollOption(store), OffsetPollOption(1), BackoffPollOption(time.Millisecond, time.Millisecond))
+
+	assert.Equal(t, fmt.Errorf("Poll: %w", context.Canceled), err)
+	assert.Equal(t, []int64{11, 12}, handled)
+	assert.Equal(t, []int64{11}, offsets)
+
+	offset, err := store.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(13), offset)
+}
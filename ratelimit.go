@@ -0,0 +1,153 @@
+package tg
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const maxRateLimitChats = 4096
+
+type chatBucket struct {
+	chatID int64
+	tokens float64
+	last   time.Time
+}
+
+// chatLimiter is a token bucket rate limiter per chat id, the buckets are kept
+// in LRU order, the full (idle) ones and the least recently used ones above
+// maxRateLimitChats are dropped.
+type chatLimiter struct {
+	mu      sync.Mutex
+	rate    float64
+	burst   float64
+	buckets map[int64]*list.Element
+	lru     *list.List
+	now     func() time.Time
+	delayed atomic.Uint64
+}
+
+func newChatLimiter(rate float64, burst int) *chatLimiter {
+	return &chatLimiter{
+		rate:    rate,
+		burst:   float64(burst),
+		buckets: make(map[int64]*list.Element),
+		lru:     list.New(),
+		now:     time.Now,
+	}
+}
+
+// full reports whether the bucket is full after the refill up to now, a bucket
+// in debt (with waiters) isn't full until the debt is paid off.
+func (l *chatLimiter) full(bucket *chatBucket, now time.Time) bool {
+	return bucket.tokens+now.Sub(bucket.last).Seconds()*l.rate >= l.burst
+}
+
+// cleanup must be called with mu held.
+func (l *chatLimiter) cleanup(now time.Time) {
+	for elem := l.lru.Back(); elem != nil; elem = l.lru.Back() {
+		bucket, _ := elem.Value.(*chatBucket)
+
+		if l.lru.Len() <= maxRateLimitChats && !l.full(bucket, now) {
+			break
+		}
+
+		l.lru.Remove(elem)
+		delete(l.buckets, bucket.chatID)
+	}
+}
+
+// reserve takes a token for chatID and returns the delay before it can be used.
+func (l *chatLimiter) reserve(chatID int64) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+
+	var bucket *chatBucket
+
+	if elem, ok := l.buckets[chatID]; ok {
+		bucket, _ = elem.Value.(*chatBucket)
+
+		l.lru.MoveToFront(elem)
+	} else {
+		bucket = &chatBucket{chatID: chatID, tokens: l.burst, last: now}
+
+		l.buckets[chatID] = l.lru.PushFront(bucket)
+	}
+
+	if elapsed := now.Sub(bucket.last); elapsed > 0 {
+		bucket.tokens = min(l.burst, bucket.tokens+elapsed.Seconds()*l.rate)
+		bucket.last = now
+	}
+
+	bucket.tokens--
+
+	l.cleanup(now)
+
+	if bucket.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-bucket.tokens / l.rate * float64(time.Second))
+}
+
+// cancel returns the token taken by reserve.
+func (l *chatLimiter) cancel(chatID int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if elem, ok := l.buckets[chatID]; ok {
+		bucket, _ := elem.Value.(*chatBucket)
+		bucket.tokens = min(l.burst, bucket.tokens+1)
+	}
+}
+
+func (l *chatLimiter) wait(ctx context.Context, chatID int64) error {
+	delay := l.reserve(chatID)
+	if delay == 0 {
+		return nil
+	}
+
+	l.delayed.Add(1)
+
+	if err := sleep(ctx, delay); err != nil {
+		l.cancel(chatID)
+
+		return err
+	}
+
+	return nil
+}
+
+var ErrIncorrectRateLimit = errors.New("incorrect rate limit")
+
+// WithPerChatRateLimit limits the messages sent or edited in the same chat
+// to rate per second with the given burst (Telegram allows about 1 per second).
+func WithPerChatRateLimit(rate float64, burst int) Option {
+	return func(cl *Client) error {
+		if rate <= 0 || burst < 1 {
+			return ErrIncorrectRateLimit
+		}
+
+		cl.chatLimiter = newChatLimiter(rate, burst)
+
+		return nil
+	}
+}
+
+func (c *Client) waitChat(ctx context.Context, chatID int64) error {
+	if c.chatLimiter == nil {
+		return nil
+	}
+
+	if err := c.chatLimiter.wait(ctx, chatID); err != nil {
+		return fmt.Errorf("rate limit: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package tg
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_chatLimiter_reserve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	limiter := newChatLimiter(1, 2)
+	limiter.now = func() time.Time { return now }
+
+	assert.Equal(t, time.Duration(0), limiter.reserve(1))
+	assert.Equal(t, time.Duration(0), limiter.reserve(1))
+	assert.Equal(t, time.Second, limiter.reserve(1))
+	assert.Equal(t, 2*time.Second, limiter.reserve(1))
+	assert.Equal(t, time.Duration(0), limiter.reserve(2))
+
+	now = now.Add(10 * time.Second)
+
+	assert.Equal(t, time.Duration(0), limiter.reserve(1))
+	assert.Equal(t, 1, limiter.lru.Len())
+}
+
+func Test_chatLimiter_cleanup(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	limiter := newChatLimiter(1, 1)
+	limiter.now = func() time.Time { return now }
+
+	for i := range maxRateLimitChats + 10 {
+		limiter.reserve(int64(i))
+	}
+
+	assert.Equal(t, maxRateLimitChats, limiter.lru.Len())
+	assert.Len(t, limiter.buckets, maxRateLimitChats)
+}
+
+func Test_chatLimiter_cleanup_Debt(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	limiter := newChatLimiter(1, 2)
+	limiter.now = func() time.Time { return now }
+
+	for range 6 {
+		limiter.reserve(1)
+	}
+
+	// idle for burst/rate since the last reserve, but 2 tokens are still owed
+	now = now.Add(2 * time.Second)
+
+	assert.Equal(t, time.Duration(0), limiter.reserve(2))
+	assert.Equal(t, 2, limiter.lru.Len())
+	assert.Equal(t, 3*time.Second, limiter.reserve(1))
+}
+
+func Test_chatLimiter_wait(t *testing.T) {
+	t.Parallel()
+
+	limiter := newChatLimiter(100, 1)
+
+	start := time.Now()
+
+	var wg sync.WaitGroup
+
+	for range 5 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			assert.NoError(t, limiter.wait(context.Background(), 1))
+		}()
+	}
+
+	wg.Wait()
+
+	assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+}
+
+func Test_chatLimiter_wait_Canceled(t *testing.T) {
+	t.Parallel()
+
+	limiter := newChatLimiter(1, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.NoError(t, limiter.wait(ctx, 1))
+	assert.ErrorIs(t, limiter.wait(ctx, 1), context.Canceled)
+}
@@ -0,0 +1,114 @@
+package tg
+
+import (
+	"errors"
+	"net/http"
+	"time"
+)
+
+var ErrIncorrectRetry = errors.New("incorrect retry")
+
+// WithRetry repeats up to retries times the calls rejected with "Too Many Requests",
+// waiting for the retry_after returned by the server. A call asked to wait longer
+// than maxRetryAfter fails immediately, zero maxRetryAfter means no limit.
+// The calls uploading files are never repeated, their readers are consumed.
+func WithRetry(retries int, maxRetryAfter time.Duration) Option {
+	return func(cl *Client) error {
+		if retries < 0 || maxRetryAfter < 0 {
+			return ErrIncorrectRetry
+		}
+
+		cl.retries = retries
+		cl.maxRetryAfter = maxRetryAfter
+
+		return nil
+	}
+}
+
+// maxServerErrorBackoff caps the delay before a repeat of a 5xx call.
+const maxServerErrorBackoff = time.Minute
+
+// WithServerErrorRetry repeats up to attempts times the calls failed with a 5xx
+// status, waiting for backoff doubled on every attempt up to a minute. The budget is separate
+// from WithRetry, a message may be sent twice if the server failed after sending it.
+func WithServerErrorRetry(attempts int, backoff time.Duration) Option {
+	return func(cl *Client) error {
+		if attempts < 0 || backoff < 0 {
+			return ErrIncorrectRetry
+		}
+
+		cl.serverErrorRetries = attempts
+		cl.serverErrorBackoff = backoff
+
+		return nil
+	}
+}
+
+// retryState counts the retries of a call per budget.
+type retryState struct {
+	rateLimited  int
+	serverErrors int
+}
+
+func isServerError(err error) bool {
+	if respErr, ok := responseError(err); ok {
+		return respErr.ErrorCode >= http.StatusInternalServerError
+	}
+
+	var statusErr *statusError
+
+	return errors.As(err, &statusErr) && statusErr.code >= http.StatusInternalServerError
+}
+
+// serverErrorDelay returns the backoff doubled attempt times, capped by
+// maxServerErrorBackoff, the doubling stops at the cap so it can't overflow.
+func (c *Client) serverErrorDelay(attempt int) time.Duration {
+	delay := c.serverErrorBackoff
+
+	for range attempt {
+		if delay >= maxServerErrorBackoff {
+			break
+		}
+
+		delay <<= 1
+	}
+
+	return min(delay, maxServerErrorBackoff)
+}
+
+func (c *Client) retryDelay(req any, err error, state *retryState) (time.Duration, bool) {
+	if err == nil {
+		return 0, false
+	}
+
+	if req != nil && len(inputFiles(req)) > 0 {
+		return 0, false
+	}
+
+	if isServerError(err) {
+		if state.serverErrors >= c.serverErrorRetries {
+			return 0, false
+		}
+
+		delay := c.serverErrorDelay(state.serverErrors)
+
+		state.serverErrors++
+
+		return delay, true
+	}
+
+	respErr, ok := responseError(err)
+	if !ok || !IsTooManyRequests(err) || state.rateLimited >= c.retries {
+		return 0, false
+	}
+
+	delay := time.Duration(respErr.Parameters.RetryAfter) * time.Second
+
+	if c.maxRetryAfter > 0 && delay > c.maxRetryAfter {
+		return 0, false
+	}
+
+	state.rateLimited++
+
+	return delay, true
+}
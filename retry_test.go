@@ -0,0 +1,245 @@
+//nolint:exhaustruct
+package tg
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testTooManyRequests = `{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 0",` +
+	`"parameters":{"retry_after":0}}`
+
+func Test_Client_API_Retry(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc     string
+		retries  int
+		maxAfter time.Duration
+		body     string
+		calls    int
+		result   bool
+	}{
+		{
+			desc:    "no_retries",
+			retries: 0,
+			body:    testTooManyRequests,
+			calls:   1,
+			result:  false,
+		},
+		{
+			desc:    "retried",
+			retries: 2,
+			body:    testTooManyRequests,
+			calls:   2,
+			result:  true,
+		},
+		{
+			desc:     "retry_after_too_long",
+			retries:  2,
+			maxAfter: time.Second,
+			body: `{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 60",` +
+				`"parameters":{"retry_after":60}}`,
+			calls:  1,
+			result: false,
+		},
+		{
+			desc:    "other_error",
+			retries: 2,
+			body:    `{"ok":false,"error_code":400,"description":"Bad Request: chat not found"}`,
+			calls:   1,
+			result:  false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			httpClient := &mockHTTPClient{}
+			httpClient.On("Do", mock.Anything).Return(func(*http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusTooManyRequests,
+					Body:       io.NopCloser(bytes.NewBufferString(test.body)),
+				}, nil
+			}).Once()
+			httpClient.On("Do", mock.Anything).Return(func(*http.Request) (*http.Response, error) {
+				return &http.Response{
+					Body: io.NopCloser(bytes.NewBufferString(`{"ok":true,"result":{"message_id":1}}`)),
+				}, nil
+			}).Maybe()
+
+			client := new(Client)
+			client.http = httpClient
+
+			assert.NoError(t, WithRetry(test.retries, test.maxAfter)(client))
+
+			_, err := client.SendMessage(context.Background(), 1, "test")
+
+			assert.Equal(t, test.result, err == nil)
+			httpClient.AssertNumberOfCalls(t, "Do", test.calls)
+		})
+	}
+}
+
+func Test_WithRetry(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewClient(testToken, WithRetry(-1, 0))
+	assert.Equal(t, fmt.Errorf("Client: %w", ErrIncorrectRetry), err)
+
+	_, err = NewClient(testToken, WithRetry(1, -time.Second))
+	assert.Equal(t, fmt.Errorf("Client: %w", ErrIncorrectRetry), err)
+
+	client, err := NewClient(testToken, WithRetry(3, time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, 3, client.retries)
+	assert.Equal(t, time.Minute, client.maxRetryAfter)
+}
+
+func Test_Client_API_ServerErrorRetry(t *testing.T) {
+	t.Parallel()
+
+	badGateway := func() (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusBadGateway,
+			Body:       io.NopCloser(bytes.NewBufferString("<html>502 Bad Gateway</html>")),
+		}, nil
+	}
+
+	tooManyRequests := func() (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Body:       io.NopCloser(bytes.NewBufferString(testTooManyRequests)),
+		}, nil
+	}
+
+	internalError := func() (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Body: io.NopCloser(bytes.NewBufferString(
+				`{"ok":false,"error_code":500,"description":"Internal Server Error"}`,
+			)),
+		}, nil
+	}
+
+	tests := []struct {
+		desc         string
+		retries      int
+		serverErrors int
+		responses    []func() (*http.Response, error)
+		calls        int
+		result       bool
+	}{
+		{
+			desc:         "no_server_error_retries",
+			retries:      1,
+			serverErrors: 0,
+			responses:    []func() (*http.Response, error){badGateway},
+			calls:        1,
+			result:       false,
+		},
+		{
+			desc:         "server_error_then_rate_limited",
+			retries:      1,
+			serverErrors: 1,
+			responses:    []func() (*http.Response, error){badGateway, tooManyRequests},
+			calls:        3,
+			result:       true,
+		},
+		{
+			desc:         "rate_limited_then_server_error",
+			retries:      1,
+			serverErrors: 1,
+			responses:    []func() (*http.Response, error){tooManyRequests, internalError},
+			calls:        3,
+			result:       true,
+		},
+		{
+			desc:         "server_error_budget",
+			retries:      2,
+			serverErrors: 1,
+			responses:    []func() (*http.Response, error){badGateway, internalError},
+			calls:        2,
+			result:       false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			httpClient := &mockHTTPClient{}
+
+			for _, response := range test.responses {
+				httpClient.On("Do", mock.Anything).Return(func(*http.Request) (*http.Response, error) {
+					return response()
+				}).Once()
+			}
+
+			httpClient.On("Do", mock.Anything).Return(func(*http.Request) (*http.Response, error) {
+				return &http.Response{
+					Body: io.NopCloser(bytes.NewBufferString(`{"ok":true,"result":{"message_id":1}}`)),
+				}, nil
+			}).Maybe()
+
+			client := new(Client)
+			client.http = httpClient
+
+			assert.NoError(t, WithRetry(test.retries, 0)(client))
+			assert.NoError(t, WithServerErrorRetry(test.serverErrors, time.Millisecond)(client))
+
+			_, err := client.SendMessage(context.Background(), 1, "test")
+
+			assert.Equal(t, test.result, err == nil)
+			httpClient.AssertNumberOfCalls(t, "Do", test.calls)
+		})
+	}
+}
+
+func Test_WithServerErrorRetry(t *testing.T) {
+	t.Parallel()
+
+	client := new(Client)
+
+	assert.Equal(t, ErrIncorrectRetry, WithServerErrorRetry(-1, 0)(client))
+	assert.Equal(t, ErrIncorrectRetry, WithServerErrorRetry(1, -time.Second)(client))
+	assert.NoError(t, WithServerErrorRetry(3, time.Second)(client))
+}
+
+func Test_Client_retryDelay_ServerErrorBackoff(t *testing.T) {
+	t.Parallel()
+
+	client := new(Client)
+
+	assert.NoError(t, WithServerErrorRetry(100, time.Second)(client))
+
+	err := &statusError{code: http.StatusBadGateway}
+	state := new(retryState)
+	delays := make([]time.Duration, 0, 100)
+
+	for {
+		delay, ok := client.retryDelay(nil, err, state)
+		if !ok {
+			break
+		}
+
+		delays = append(delays, delay)
+	}
+
+	assert.Len(t, delays, 100)
+	assert.Equal(t, time.Second, delays[0])
+	assert.Equal(t, 32*time.Second, delays[5])
+
+	for _, delay := range delays[6:] {
+		assert.Equal(t, maxServerErrorBackoff, delay)
+	}
+}
@@ -0,0 +1,151 @@
+package tg
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryTransport is an http.RoundTripper repeating the requests answered with
+// a retryable status (default 429 and 5xx), so the retry policy can be used with
+// any HTTPClient (see WithHTTPClient):
+//
+//	transport, err := tg.NewRetryTransport(http.DefaultTransport,
+//		tg.AttemptsRetryTransportOption(3),
+//	)
+//	client, err := tg.NewClient(token, tg.WithHTTPClient(&http.Client{Transport: transport}))
+//
+// The delay is the Retry-After header of the response or the backoff doubled
+// on every attempt, capped by the max delay. The failed round trips and the requests whose body can't
+// be replayed (no GetBody, e.g. the streamed uploads) are not repeated.
+type RetryTransport struct {
+	transport http.RoundTripper
+	attempts  int
+	backoff   time.Duration
+	maxDelay  time.Duration
+	retryable func(status int) bool
+}
+
+type RetryTransportOption func(*RetryTransport)
+
+// AttemptsRetryTransportOption sets the number of the repeats (default 3).
+func AttemptsRetryTransportOption(attempts int) RetryTransportOption {
+	return func(rt *RetryTransport) {
+		rt.attempts = attempts
+	}
+}
+
+// BackoffRetryTransportOption sets the delay before the first repeat (default 1s).
+func BackoffRetryTransportOption(backoff time.Duration) RetryTransportOption {
+	return func(rt *RetryTransport) {
+		rt.backoff = backoff
+	}
+}
+
+// MaxDelayRetryTransportOption sets the max delay before a repeat (default 1m),
+// including the one asked by Retry-After.
+func MaxDelayRetryTransportOption(maxDelay time.Duration) RetryTransportOption {
+	return func(rt *RetryTransport) {
+		rt.maxDelay = maxDelay
+	}
+}
+
+// RetryableRetryTransportOption sets the predicate of the retryable statuses.
+func RetryableRetryTransportOption(retryable func(status int) bool) RetryTransportOption {
+	return func(rt *RetryTransport) {
+		rt.retryable = retryable
+	}
+}
+
+// IsRetryableStatus reports whether status is 429 or 5xx.
+func IsRetryableStatus(status int) bool {
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+var ErrRetryablePredicateNil = errors.New("retryable predicate is nil")
+
+//nolint:gomnd
+func NewRetryTransport(transport http.RoundTripper, opts ...RetryTransportOption) (*RetryTransport, error) {
+	if transport == nil {
+		return nil, fmt.Errorf("RetryTransport: %w", ErrTransportNil)
+	}
+
+	rt := &RetryTransport{
+		transport: transport,
+		attempts:  3,
+		backoff:   time.Second,
+		maxDelay:  time.Minute,
+		retryable: IsRetryableStatus,
+	}
+
+	for _, opt := range opts {
+		opt(rt)
+	}
+
+	if rt.attempts < 0 || rt.backoff < 0 || rt.maxDelay <= 0 {
+		return nil, fmt.Errorf("RetryTransport: %w", ErrIncorrectRetry)
+	}
+
+	// the backoff of the last attempt must not overflow
+	if rt.backoff > 0 && rt.attempts > bits.LeadingZeros64(uint64(rt.backoff)) {
+		return nil, fmt.Errorf("RetryTransport: %w", ErrIncorrectRetry)
+	}
+
+	if rt.retryable == nil {
+		return nil, fmt.Errorf("RetryTransport: %w", ErrRetryablePredicateNil)
+	}
+
+	return rt, nil
+}
+
+func (rt *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := rt.transport.RoundTrip(req)
+		if err != nil || attempt >= rt.attempts || !rt.retryable(resp.StatusCode) {
+			return resp, err //nolint:wrapcheck
+		}
+
+		if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+			return resp, nil
+		}
+
+		delay := min(retryAfter(resp, rt.backoff<<attempt, rt.maxDelay), rt.maxDelay)
+
+		// the connection is reused only if the body is read to the end
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+		resp.Body.Close()
+
+		if err := sleep(req.Context(), delay); err != nil {
+			return nil, err
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err //nolint:wrapcheck
+			}
+
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// retryAfter returns the delay of the Retry-After header in seconds or backoff,
+// the header is capped by maxDelay.
+func retryAfter(resp *http.Response, backoff, maxDelay time.Duration) time.Duration {
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return backoff
+	}
+
+	if seconds > int(maxDelay/time.Second) {
+		return maxDelay
+	}
+
+	return time.Duration(seconds) * time.Second
+}
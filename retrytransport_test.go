@@ -0,0 +1,215 @@
+//nolint:exhaustruct
+package tg
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// stubTransport answers with the statuses in order and records the request bodies.
+func stubTransport(statuses []int, bodies *[]string) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		body := ""
+
+		if req.Body != nil {
+			data, _ := io.ReadAll(req.Body)
+			body = string(data)
+		}
+
+		*bodies = append(*bodies, body)
+		status := statuses[min(len(*bodies), len(statuses))-1]
+
+		return &http.Response{
+			StatusCode: status,
+			Header:     http.Header{},
+			Body:       io.NopCloser(bytes.NewBufferString(`{"ok":true,"result":true}`)),
+		}, nil
+	})
+}
+
+func Test_NewRetryTransport(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewRetryTransport(nil)
+	assert.Equal(t, fmt.Errorf("RetryTransport: %w", ErrTransportNil), err)
+
+	_, err = NewRetryTransport(http.DefaultTransport, AttemptsRetryTransportOption(-1))
+	assert.Equal(t, fmt.Errorf("RetryTransport: %w", ErrIncorrectRetry), err)
+
+	_, err = NewRetryTransport(http.DefaultTransport, MaxDelayRetryTransportOption(0))
+	assert.Equal(t, fmt.Errorf("RetryTransport: %w", ErrIncorrectRetry), err)
+
+	_, err = NewRetryTransport(http.DefaultTransport, AttemptsRetryTransportOption(64))
+	assert.Equal(t, fmt.Errorf("RetryTransport: %w", ErrIncorrectRetry), err)
+
+	_, err = NewRetryTransport(http.DefaultTransport, AttemptsRetryTransportOption(34))
+	assert.NoError(t, err)
+
+	_, err = NewRetryTransport(http.DefaultTransport, RetryableRetryTransportOption(nil))
+	assert.Equal(t, fmt.Errorf("RetryTransport: %w", ErrRetryablePredicateNil), err)
+}
+
+func Test_retryAfter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		header string
+		result time.Duration
+	}{
+		{
+			desc:   "backoff",
+			header: "",
+			result: time.Second,
+		},
+		{
+			desc:   "retry_after",
+			header: "5",
+			result: 5 * time.Second,
+		},
+		{
+			desc:   "max_delay",
+			header: "9223372036854775807",
+			result: time.Minute,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &http.Response{Header: http.Header{}}
+			resp.Header.Set("Retry-After", test.header)
+
+			assert.Equal(t, test.result, retryAfter(resp, time.Second, time.Minute))
+		})
+	}
+}
+
+func Test_RetryTransport_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc      string
+		statuses  []int
+		opts      []RetryTransportOption
+		status    int
+		roundTrip int
+	}{
+		{
+			desc:      "ok",
+			statuses:  []int{http.StatusOK},
+			status:    http.StatusOK,
+			roundTrip: 1,
+		},
+		{
+			desc:      "server_error",
+			statuses:  []int{http.StatusBadGateway, http.StatusTooManyRequests, http.StatusOK},
+			status:    http.StatusOK,
+			roundTrip: 3,
+		},
+		{
+			desc:      "attempts",
+			statuses:  []int{http.StatusServiceUnavailable},
+			opts:      []RetryTransportOption{AttemptsRetryTransportOption(2)},
+			status:    http.StatusServiceUnavailable,
+			roundTrip: 3,
+		},
+		{
+			desc:      "not_retryable",
+			statuses:  []int{http.StatusBadRequest},
+			status:    http.StatusBadRequest,
+			roundTrip: 1,
+		},
+		{
+			desc:     "predicate",
+			statuses: []int{http.StatusBadRequest, http.StatusOK},
+			opts: []RetryTransportOption{RetryableRetryTransportOption(func(status int) bool {
+				return status == http.StatusBadRequest
+			})},
+			status:    http.StatusOK,
+			roundTrip: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			var bodies []string
+
+			opts := append([]RetryTransportOption{BackoffRetryTransportOption(time.Millisecond)}, test.opts...)
+
+			transport, err := NewRetryTransport(stubTransport(test.statuses, &bodies), opts...)
+			assert.NoError(t, err)
+
+			req, err := http.NewRequestWithContext(context.Background(),
+				http.MethodPost, "http://tg.test", strings.NewReader("body"))
+			assert.NoError(t, err)
+
+			resp, err := transport.RoundTrip(req)
+			assert.NoError(t, err)
+			assert.Equal(t, test.status, resp.StatusCode)
+			assert.Len(t, bodies, test.roundTrip)
+
+			for _, body := range bodies {
+				assert.Equal(t, "body", body)
+			}
+		})
+	}
+}
+
+func Test_RetryTransport_NotReplayable(t *testing.T) {
+	t.Parallel()
+
+	var bodies []string
+
+	transport, err := NewRetryTransport(stubTransport([]int{http.StatusBadGateway}, &bodies),
+		BackoffRetryTransportOption(time.Millisecond),
+	)
+	assert.NoError(t, err)
+
+	req, err := http.NewRequestWithContext(context.Background(),
+		http.MethodPost, "http://tg.test", io.NopCloser(strings.NewReader("body")))
+	assert.NoError(t, err)
+
+	resp, err := transport.RoundTrip(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	assert.Len(t, bodies, 1)
+}
+
+func Test_RetryTransport_Client(t *testing.T) {
+	t.Parallel()
+
+	var bodies []string
+
+	transport, err := NewRetryTransport(
+		stubTransport([]int{http.StatusInternalServerError, http.StatusOK}, &bodies),
+		BackoffRetryTransportOption(time.Millisecond),
+	)
+	assert.NoError(t, err)
+
+	client, err := NewClient(testToken, WithHTTPClient(&http.Client{Transport: transport}))
+	assert.NoError(t, err)
+
+	deleted, err := client.DeleteMessage(context.Background(), 1, 2)
+
+	assert.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Len(t, bodies, 2)
+}
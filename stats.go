@@ -0,0 +1,55 @@
+package tg
+
+import (
+	"sync/atomic"
+)
+
+// Stats is a snapshot of the client counters.
+type Stats struct {
+	// Requests is the number of API calls.
+	Requests uint64
+	// Retries is the number of calls repeated after an error (e.g. by Poll).
+	Retries uint64
+	// RateLimited is the number of calls delayed by WithPerChatRateLimit
+	// plus the number of "Too Many Requests" responses.
+	RateLimited uint64
+	// Errors is the number of failed API calls.
+	Errors uint64
+}
+
+type stats struct {
+	requests    atomic.Uint64
+	retries     atomic.Uint64
+	rateLimited atomic.Uint64
+	errors      atomic.Uint64
+}
+
+func (s *stats) request(err error) {
+	s.requests.Add(1)
+
+	if err == nil {
+		return
+	}
+
+	s.errors.Add(1)
+
+	if IsTooManyRequests(err) {
+		s.rateLimited.Add(1)
+	}
+}
+
+// Stats returns the counters collected since the client creation.
+func (c *Client) Stats() Stats {
+	result := Stats{
+		Requests:    c.stats.requests.Load(),
+		Retries:     c.stats.retries.Load(),
+		RateLimited: c.stats.rateLimited.Load(),
+		Errors:      c.stats.errors.Load(),
+	}
+
+	if c.chatLimiter != nil {
+		result.RateLimited += c.chatLimiter.delayed.Load()
+	}
+
+	return result
+}
@@ -0,0 +1,49 @@
+//nolint:exhaustruct
+package tg
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_Client_Stats(t *testing.T) {
+	t.Parallel()
+
+	bodies := []string{
+		`{"ok":true,"result":{"message_id":1}}`,
+		`{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 1"}`,
+		`{"ok":false,"error_code":400,"description":"Bad Request: chat not found"}`,
+		`{"ok":true,"result":{"message_id":2}}`,
+	}
+
+	httpClient := newMockHTTPClient(t)
+
+	for _, body := range bodies {
+		httpClient.On("Do", mock.Anything).Return(func(*http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusBadRequest,
+				Body:       io.NopCloser(bytes.NewBufferString(body)),
+			}, nil
+		}).Once()
+	}
+
+	now := time.Now()
+
+	client := new(Client)
+	client.http = httpClient
+	client.chatLimiter = newChatLimiter(1000, 3)
+	client.chatLimiter.now = func() time.Time { return now }
+
+	for range bodies {
+		_, _ = client.SendMessage(context.Background(), 1, "test")
+	}
+
+	assert.Equal(t, Stats{Requests: 4, Retries: 0, RateLimited: 2, Errors: 2}, client.Stats())
+}
@@ -0,0 +1,106 @@
+package tg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+type Sticker struct {
+	FileID       string `json:"file_id"`
+	FileUniqueID string `json:"file_unique_id"`
+	Type         string `json:"type"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+	IsAnimated   bool   `json:"is_animated"`
+	IsVideo      bool   `json:"is_video"`
+	Emoji        string `json:"emoji,omitempty"`
+	SetName      string `json:"set_name,omitempty"`
+	FileSize     int64  `json:"file_size,omitempty"`
+}
+
+// SendStickerMessage is the sendSticker request, the sticker is a .WEBP, .TGS
+// or .WEBM file, a file_id or a HTTP URL of a .WEBP file.
+type SendStickerMessage struct {
+	ChatID          int64     `json:"chat_id"`
+	MessageThreadID int64     `json:"message_thread_id,omitempty"`
+	Sticker         InputFile `json:"sticker"`
+	Emoji           string    `json:"emoji,omitempty"`
+}
+
+var ErrEmptySticker = errors.New("empty sticker")
+
+func (ss *SendStickerMessage) Validate() error {
+	if ss.ChatID == 0 {
+		return ErrEmptyChatID
+	}
+
+	if err := validateMessageThreadID(ss.MessageThreadID); err != nil {
+		return err
+	}
+
+	if ss.Sticker.IsZero() {
+		return ErrEmptySticker
+	}
+
+	return nil
+}
+
+type SendStickerOption func(*SendStickerMessage)
+
+func NewSendStickerMessage(chatID int64, sticker InputFile, opts ...SendStickerOption) (*SendStickerMessage, error) {
+	ss := new(SendStickerMessage)
+
+	for _, opt := range opts {
+		opt(ss)
+	}
+
+	ss.ChatID = chatID
+	ss.Sticker = sticker
+
+	if err := ss.Validate(); err != nil {
+		return nil, fmt.Errorf("SendStickerMessage: %w", err)
+	}
+
+	return ss, nil
+}
+
+func MessageThreadIDSendStickerOption(threadID int64) SendStickerOption {
+	return func(ss *SendStickerMessage) {
+		ss.MessageThreadID = threadID
+	}
+}
+
+// EmojiSendStickerOption sets the emoji associated with the uploaded sticker.
+func EmojiSendStickerOption(emoji string) SendStickerOption {
+	return func(ss *SendStickerMessage) {
+		ss.Emoji = emoji
+	}
+}
+
+const sendStickerMethod = "sendSticker"
+
+func (c *Client) SendSticker(ctx context.Context,
+	chatID int64, sticker InputFile, opts ...SendStickerOption,
+) (*Message, error) {
+	req, err := NewSendStickerMessage(chatID, sticker, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("SendSticker: %w", err)
+	}
+
+	if err := c.allowChat(chatID); err != nil {
+		return nil, fmt.Errorf("SendSticker: %w", err)
+	}
+
+	if err := c.waitChat(ctx, chatID); err != nil {
+		return nil, fmt.Errorf("SendSticker: %w", err)
+	}
+
+	resp := new(Message)
+
+	if err := c.API(ctx, sendStickerMethod, req, resp); err != nil {
+		return nil, fmt.Errorf("SendSticker: %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,96 @@
+//nolint:exhaustruct
+package tg
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_SendStickerMessage_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		msg    *SendStickerMessage
+		result error
+	}{
+		{
+			desc:   ErrEmptyChatID.Error(),
+			msg:    &SendStickerMessage{},
+			result: ErrEmptyChatID,
+		},
+		{
+			desc: ErrIncorrectMessageThreadID.Error(),
+			msg: &SendStickerMessage{
+				ChatID:          1,
+				MessageThreadID: -1,
+				Sticker:         FileFromID("test"),
+			},
+			result: ErrIncorrectMessageThreadID,
+		},
+		{
+			desc:   ErrEmptySticker.Error(),
+			msg:    &SendStickerMessage{ChatID: 1},
+			result: ErrEmptySticker,
+		},
+		{
+			desc:   "nil_result",
+			msg:    &SendStickerMessage{ChatID: 1, Sticker: FileFromURL("https://example.com/sticker.webp")},
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.msg.Validate(), test.result)
+		})
+	}
+}
+
+func Test_Client_SendSticker(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		if err := req.ParseMultipartForm(1 << 20); err != nil {
+			return false
+		}
+
+		file, header, err := req.FormFile("sticker")
+		if err != nil {
+			return false
+		}
+
+		data, _ := io.ReadAll(file)
+
+		return header.Filename == "sticker.webp" && string(data) == "sticker" &&
+			req.FormValue("chat_id") == "1" && req.FormValue("emoji") == "👍"
+	})).Return(
+		&http.Response{
+			Body: io.NopCloser(bytes.NewBufferString(
+				`{"ok":true,"result":{"message_id":1,"sticker":{"file_id":"test","width":512,"height":512,` +
+					`"is_animated":true}}}`,
+			)),
+		},
+		nil,
+	)
+
+	client := new(Client)
+	client.http = httpClient
+
+	msg, err := client.SendSticker(context.Background(), 1,
+		FileFromReader("sticker.webp", bytes.NewBufferString("sticker")),
+		EmojiSendStickerOption("👍"),
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &Sticker{FileID: "test", Width: 512, Height: 512, IsAnimated: true}, msg.Sticker)
+}
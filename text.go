@@ -0,0 +1,155 @@
+package tg
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf16"
+)
+
+// utf16Len returns the length of s in UTF-16 code units, the way
+// Telegram counts text and caption sizes.
+func utf16Len(s string) int {
+	return len(utf16.Encode([]rune(s)))
+}
+
+const ellipsis = "…"
+
+// TruncateText truncates text to size UTF-16 code units, the cut is made on
+// a rune boundary and "…" is appended (and counted in size) if truncated.
+func TruncateText(text string, size int) string {
+	if utf16Len(text) <= size {
+		return text
+	}
+
+	if size < 1 {
+		return ""
+	}
+
+	limit := size - utf16Len(ellipsis)
+	units := 0
+
+	for i, r := range text {
+		units += len(utf16.Encode([]rune{r}))
+
+		if units > limit {
+			return text[:i] + ellipsis
+		}
+	}
+
+	return text
+}
+
+// truncateEscapedText escapes text for the mode (see EscapeText) and truncates
+// the result like TruncateText, the size counts the escapes and an escape is
+// never split.
+func truncateEscapedText(mode ParseMode, text string, size int) string {
+	escaped := EscapeText(mode, text)
+
+	if utf16Len(escaped) <= size {
+		return escaped
+	}
+
+	if size < 1 {
+		return ""
+	}
+
+	limit := size - utf16Len(ellipsis)
+	units := 0
+
+	var builder strings.Builder
+
+	for _, r := range text {
+		chunk := EscapeText(mode, string(r))
+		units += utf16Len(chunk)
+
+		if units > limit {
+			break
+		}
+
+		builder.WriteString(chunk)
+	}
+
+	return builder.String() + ellipsis
+}
+
+//nolint:gochecknoglobals
+var (
+	markdownV2Replacer = newEscapeReplacer("\\_*[]()~`>#+-=|{}.!")
+	markdownReplacer   = newEscapeReplacer("\\_*`[")
+	htmlReplacer       = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+)
+
+// newEscapeReplacer returns a replacer which prefixes chars with a backslash.
+func newEscapeReplacer(chars string) *strings.Replacer {
+	oldnew := make([]string, 0, len(chars)*2) //nolint:gomnd
+
+	for _, char := range chars {
+		oldnew = append(oldnew, string(char), "\\"+string(char))
+	}
+
+	return strings.NewReplacer(oldnew...)
+}
+
+// EscapeMarkdownV2 escapes all the characters which have a meaning in MarkdownV2.
+func EscapeMarkdownV2(s string) string {
+	return markdownV2Replacer.Replace(s)
+}
+
+// EscapeMarkdown escapes the entity characters of the legacy Markdown.
+func EscapeMarkdown(s string) string {
+	return markdownReplacer.Replace(s)
+}
+
+// EscapeHTML replaces "<", ">" and "&" with the HTML entities.
+func EscapeHTML(s string) string {
+	return htmlReplacer.Replace(s)
+}
+
+// EscapeText escapes s for the parse mode, the text is returned as is
+// for an empty or unknown mode.
+func EscapeText(mode ParseMode, s string) string {
+	switch mode {
+	case MarkdownV2ParseMode:
+		return EscapeMarkdownV2(s)
+	case MarkdownParseMode:
+		return EscapeMarkdown(s)
+	case HTMLParseMode:
+		return EscapeHTML(s)
+	default:
+		return s
+	}
+}
+
+//nolint:gochecknoglobals
+var (
+	regexpHTMLTag = regexp.MustCompile(
+		`</?(b|strong|i|em|u|ins|s|strike|del|a|code|pre|span|tg-spoiler|tg-emoji|blockquote)(\s[^>]*)?>`)
+	regexpMarkdown = regexp.MustCompile("```|`[^`\n]+`" +
+		`|(^|\W)\*[^*\s][^*\n]*\*(\W|$)|(^|\W)_[^_\s][^_\n]*_(\W|$)|\[[^\]\n]+\]\([^)\s]+\)`)
+	regexpMarkdownV2 = regexp.MustCompile(
+		`~[^~\s][^~\n]*~|\|\|[^|\n]+\|\||__[^_\n]+__|\\[_*\[\]()~` + "`" + `>#+\-=|{}.!]`)
+)
+
+// DetectParseMode returns the parse mode of the markup found in text: HTML
+// for the supported tags, MarkdownV2 for its own entities (strikethrough,
+// spoiler, underline) or escapes, Markdown for the common entities, and
+// an empty mode for a text without a markup or with both HTML and Markdown.
+// The detection is a best-effort heuristic.
+func DetectParseMode(text string) ParseMode {
+	html := regexpHTMLTag.MatchString(text)
+	markdownV2 := regexpMarkdownV2.MatchString(text)
+	markdown := markdownV2 || regexpMarkdown.MatchString(text)
+
+	switch {
+	case html && markdown:
+		return ""
+	case html:
+		return HTMLParseMode
+	case markdownV2:
+		return MarkdownV2ParseMode
+	case markdown:
+		return MarkdownParseMode
+	default:
+		return ""
+	}
+}
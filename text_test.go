@@ -0,0 +1,253 @@
+//nolint:exhaustruct
+package tg
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_TruncateText(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		text   string
+		size   int
+		result string
+	}{
+		{
+			desc:   "short",
+			text:   "test",
+			size:   4,
+			result: "test",
+		},
+		{
+			desc:   "ascii",
+			text:   "testing",
+			size:   5,
+			result: "test…",
+		},
+		{
+			desc:   "multibyte",
+			text:   "тестирование",
+			size:   5,
+			result: "тест…",
+		},
+		{
+			desc:   "surrogate_pair",
+			text:   "ab😀😀",
+			size:   4,
+			result: "ab…",
+		},
+		{
+			desc:   "surrogate_pair_fit",
+			text:   "ab😀😀",
+			size:   5,
+			result: "ab😀…",
+		},
+		{
+			desc:   "zero",
+			text:   "test",
+			size:   0,
+			result: "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			result := TruncateText(test.text, test.size)
+
+			assert.Equal(t, test.result, result)
+			assert.LessOrEqual(t, utf16Len(result), test.size)
+		})
+	}
+}
+
+func Test_NewSendMessage_Truncate(t *testing.T) {
+	t.Parallel()
+
+	text := strings.Repeat("😀", MaxTextSize)
+
+	_, err := NewSendMessage(1, text)
+	assert.ErrorIs(t, err, ErrTextTooLong)
+
+	msg, err := NewSendMessage(1, text, TruncateSendOption(true))
+	assert.NoError(t, err)
+	assert.Equal(t, MaxTextSize-1, utf16Len(msg.Text))
+	assert.True(t, strings.HasSuffix(msg.Text, "😀…"))
+}
+
+func Test_NewSendMessage_TruncateSafeText(t *testing.T) {
+	t.Parallel()
+
+	text := strings.Repeat("a.", MaxTextSize)
+
+	msg, err := NewSendMessage(1, text,
+		TruncateSendOption(true),
+		SafeTextSendOption(MarkdownV2ParseMode),
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, MaxTextSize, utf16Len(msg.Text))
+	assert.True(t, strings.HasSuffix(msg.Text, "a\\.…"))
+
+	msg, err = NewSendMessage(1, strings.Repeat("&", MaxTextSize),
+		TruncateSendOption(true),
+		SafeTextSendOption(HTMLParseMode),
+	)
+
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, utf16Len(msg.Text), MaxTextSize)
+	assert.True(t, strings.HasSuffix(msg.Text, "&amp;…"))
+}
+
+func Test_NewSendMessage_OnTruncate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		text   string
+		result []int
+	}{
+		{
+			desc:   "truncated",
+			text:   strings.Repeat("a", MaxTextSize+10),
+			result: []int{MaxTextSize + 10},
+		},
+		{
+			desc:   "not_truncated",
+			text:   strings.Repeat("a", MaxTextSize),
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			var sizes []int
+
+			msg, err := NewSendMessage(1, test.text,
+				TruncateSendOption(true),
+				OnTruncateSendOption(func(size int) { sizes = append(sizes, size) }),
+			)
+
+			assert.NoError(t, err)
+			assert.LessOrEqual(t, utf16Len(msg.Text), MaxTextSize)
+			assert.Equal(t, test.result, sizes)
+		})
+	}
+}
+
+func Test_EscapeText(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		mode   ParseMode
+		text   string
+		result string
+	}{
+		{
+			desc:   "markdown_v2",
+			mode:   MarkdownV2ParseMode,
+			text:   "\\_*[]()~`>#+-=|{}.!",
+			result: "\\\\\\_\\*\\[\\]\\(\\)\\~\\`\\>\\#\\+\\-\\=\\|\\{\\}\\.\\!",
+		},
+		{
+			desc:   "markdown_v2_text",
+			mode:   MarkdownV2ParseMode,
+			text:   "v1.2-rc! done",
+			result: "v1\\.2\\-rc\\! done",
+		},
+		{
+			desc:   "markdown",
+			mode:   MarkdownParseMode,
+			text:   "\\_*`[].!",
+			result: "\\\\\\_\\*\\`\\[].!",
+		},
+		{
+			desc:   "html",
+			mode:   HTMLParseMode,
+			text:   "<b>a & b</b>",
+			result: "&lt;b&gt;a &amp; b&lt;/b&gt;",
+		},
+		{
+			desc:   "empty_mode",
+			mode:   "",
+			text:   "<b>*a*</b>",
+			result: "<b>*a*</b>",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.result, EscapeText(test.mode, test.text))
+		})
+	}
+}
+
+func Test_NewSendMessage_SafeText(t *testing.T) {
+	t.Parallel()
+
+	msg, err := NewSendMessage(1, "1 + 1 = 2.", SafeTextSendOption(MarkdownV2ParseMode))
+
+	assert.NoError(t, err)
+	assert.Equal(t, MarkdownV2ParseMode, string(msg.ParseMode))
+	assert.Equal(t, "1 \\+ 1 \\= 2\\.", msg.Text)
+
+	msg, err = NewSendMessage(1, "1 + 1", SafeTextSendOption(MarkdownV2ParseMode), PlainTextSendOption())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "1 + 1", msg.Text)
+}
+
+func Test_DetectParseMode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		text   string
+		result ParseMode
+	}{
+		{desc: "html", text: "build <b>passed</b>, see <a href=\"https://example.com\">logs</a>", result: HTMLParseMode},
+		{desc: "markdown_bold", text: "build *passed*", result: MarkdownParseMode},
+		{desc: "markdown_link", text: "see [logs](https://example.com)", result: MarkdownParseMode},
+		{desc: "markdown_code", text: "run `make test`", result: MarkdownParseMode},
+		{desc: "markdown_v2_strike", text: "~old~ *new*", result: MarkdownV2ParseMode},
+		{desc: "markdown_v2_escape", text: "version 1\\.2", result: MarkdownV2ParseMode},
+		{desc: "plain", text: "build passed in 2.5s!", result: ""},
+		{desc: "plain_snake_case", text: "file_name_here and 2*3*4", result: ""},
+		{desc: "plain_comparison", text: "a < b > c", result: ""},
+		{desc: "ambiguous", text: "<b>bold</b> and *bold*", result: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.result, DetectParseMode(test.text))
+		})
+	}
+}
+
+func Test_NewSendMessage_AutoParseMode(t *testing.T) {
+	t.Parallel()
+
+	msg, err := NewSendMessage(1, "<i>test</i>", AutoParseModeSendOption())
+
+	assert.NoError(t, err)
+	assert.Equal(t, ParseMode(HTMLParseMode), msg.ParseMode)
+
+	msg, err = NewSendMessage(1, "test", ParseModeSendOption(HTMLParseMode), AutoParseModeSendOption())
+
+	assert.NoError(t, err)
+	assert.Equal(t, ParseMode(""), msg.ParseMode)
+	assert.True(t, msg.flags.plainText)
+}
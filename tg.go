@@ -2,17 +2,25 @@ package tg
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
+	"math"
+	"mime/multipart"
+	"net"
 	"net/http"
 	"net/url"
 	"reflect"
 	"regexp"
 	"slices"
+	"strings"
 	"time"
+	"unicode"
 )
 
 type ParseMode string
@@ -40,35 +48,247 @@ func (m ParseMode) Validate() error {
 	return nil
 }
 
+type ChatAction string
+
+const (
+	TypingChatAction          = "typing"
+	UploadPhotoChatAction     = "upload_photo"
+	RecordVideoChatAction     = "record_video"
+	UploadVideoChatAction     = "upload_video"
+	RecordVoiceChatAction     = "record_voice"
+	UploadVoiceChatAction     = "upload_voice"
+	UploadDocumentChatAction  = "upload_document"
+	ChooseStickerChatAction   = "choose_sticker"
+	FindLocationChatAction    = "find_location"
+	RecordVideoNoteChatAction = "record_video_note"
+	UploadVideoNoteChatAction = "upload_video_note"
+)
+
+var chatActionList = []ChatAction{ //nolint:gochecknoglobals
+	TypingChatAction,
+	UploadPhotoChatAction,
+	RecordVideoChatAction,
+	UploadVideoChatAction,
+	RecordVoiceChatAction,
+	UploadVoiceChatAction,
+	UploadDocumentChatAction,
+	ChooseStickerChatAction,
+	FindLocationChatAction,
+	RecordVideoNoteChatAction,
+	UploadVideoNoteChatAction,
+}
+
+var ErrUnknownChatAction = errors.New("unknown chat action")
+
+func (a ChatAction) Validate() error {
+	if !slices.Contains(chatActionList, a) {
+		return ErrUnknownChatAction
+	}
+
+	return nil
+}
+
+var ErrIncorrectMessageThreadID = errors.New("incorrect message_thread_id")
+
+// validateMessageThreadID checks the forum topic (or the thread of a supergroup)
+// of a sent message, zero is the general topic.
+func validateMessageThreadID(threadID int64) error {
+	if threadID < 0 {
+		return ErrIncorrectMessageThreadID
+	}
+
+	return nil
+}
+
+type BusinessConnection struct {
+	BusinessConnectionID string `json:"business_connection_id,omitempty" tg:"omitzero"`
+}
+
+var ErrIncorrectBusinessConnectionID = errors.New("incorrect business_connection_id")
+
+func (bc *BusinessConnection) Validate() error {
+	if strings.ContainsFunc(bc.BusinessConnectionID, unicode.IsSpace) {
+		return ErrIncorrectBusinessConnectionID
+	}
+
+	return nil
+}
+
+// BusinessConnectionOption is the business_connection_id shared by the send,
+// edit, delete and live location options of the messages of a business account:
+//
+//	opt := tg.BusinessConnectionOption(connectionID)
+//	msg, err := client.SendMessage(ctx, chatID, "text", opt.Send())
+//	_, err = client.DeleteMessage(ctx, chatID, msg.MessageID, opt.Delete())
+type BusinessConnectionOption string
+
+func (o BusinessConnectionOption) set(bc *BusinessConnection) {
+	bc.BusinessConnectionID = string(o)
+}
+
+func (o BusinessConnectionOption) Send() SendOption {
+	return func(sm *SendMessage) { o.set(&sm.BusinessConnection) }
+}
+
+func (o BusinessConnectionOption) Edit() EditOption {
+	return func(em *EditMessage) { o.set(&em.BusinessConnection) }
+}
+
+func (o BusinessConnectionOption) Delete() DeleteOption {
+	return func(dm *DeleteMessage) { o.set(&dm.BusinessConnection) }
+}
+
+func (o BusinessConnectionOption) EditLiveLocation() EditLiveLocationOption {
+	return func(el *EditMessageLiveLocation) { o.set(&el.BusinessConnection) }
+}
+
+func (o BusinessConnectionOption) StopLiveLocation() StopLiveLocationOption {
+	return func(sl *StopMessageLiveLocation) { o.set(&sl.BusinessConnection) }
+}
+
+// MessageEntity is a special entity of a text (e.g. bold, text_link), Offset
+// and Length are in UTF-16 code units.
+type MessageEntity struct {
+	Type          string `json:"type"`
+	Offset        int    `json:"offset"`
+	Length        int    `json:"length"`
+	URL           string `json:"url,omitempty"`
+	User          *User  `json:"user,omitempty"`
+	Language      string `json:"language,omitempty"`
+	CustomEmojiID string `json:"custom_emoji_id,omitempty"`
+}
+
+var (
+	ErrEmptyEntityType      = errors.New("empty entity type")
+	ErrIncorrectEntityRange = errors.New("incorrect entity offset or length")
+)
+
+func (me *MessageEntity) Validate() error {
+	if me.Type == "" {
+		return ErrEmptyEntityType
+	}
+
+	if me.Offset < 0 || me.Length <= 0 {
+		return ErrIncorrectEntityRange
+	}
+
+	return nil
+}
+
+// BaseMessage is the text of a message, the text is formatted either by
+// ParseMode or by Entities, parse_mode is never sent with entities.
+//
+// ChatID is a user id (positive), a group id (negative) or a supergroup
+// or channel id (-100 followed by the id, e.g. -1001234567890), only 0 is
+// rejected by Validate, see WithStrictChatID for a stricter check.
 type BaseMessage struct {
-	ChatID    int64     `json:"chat_id"`
-	Text      string    `json:"text"`
-	ParseMode ParseMode `json:"parse_mode,omitempty"`
+	ChatID    int64           `json:"chat_id"`
+	Text      string          `json:"text"`
+	ParseMode ParseMode       `json:"parse_mode,omitempty"`
+	Entities  []MessageEntity `json:"entities,omitempty"`
+	BusinessConnection
 }
 
+// MaxTextSize is the max text size in UTF-16 code units (not bytes),
+// the way Telegram counts it.
 const MaxTextSize int = 4096
 
 var (
-	ErrEmptyChatID = errors.New("empty chat_id")
-	ErrEmptyText   = errors.New("empty text")
-	ErrTextTooLong = errors.New("text too long")
+	ErrEmptyChatID           = errors.New("empty chat_id")
+	ErrEmptyText             = errors.New("empty text")
+	ErrTextTooLong           = errors.New("text too long")
+	ErrParseModeWithEntities = errors.New("parse_mode with entities")
 )
 
-func (bm *BaseMessage) Validate() error {
+func firstError(errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errs[0]
+}
+
+func (bm *BaseMessage) validationErrors() []error {
+	var errs []error
+
 	if bm.ChatID == 0 {
-		return ErrEmptyChatID
+		errs = append(errs, ErrEmptyChatID)
 	}
 
 	if bm.Text == "" {
-		return ErrEmptyText
+		errs = append(errs, ErrEmptyText)
+	} else if utf16Len(bm.Text) > MaxTextSize {
+		errs = append(errs, ErrTextTooLong)
 	}
 
-	if len(bm.Text) > MaxTextSize {
-		return ErrTextTooLong
+	if err := bm.ParseMode.Validate(); err != nil {
+		errs = append(errs, err)
 	}
 
-	if err := bm.ParseMode.Validate(); err != nil {
-		return err
+	if bm.ParseMode != "" && len(bm.Entities) > 0 {
+		errs = append(errs, ErrParseModeWithEntities)
+	}
+
+	for i := range bm.Entities {
+		if err := bm.Entities[i].Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("entities[%d]: %w", i, err))
+
+			break
+		}
+	}
+
+	if err := bm.BusinessConnection.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+func (bm *BaseMessage) Validate() error {
+	return firstError(bm.validationErrors())
+}
+
+// beforeMarshal drops the parse mode of the text formatted by the entities
+// (e.g. sent with WithSkipValidation), Telegram rejects the both.
+func (bm *BaseMessage) beforeMarshal() {
+	if len(bm.Entities) > 0 {
+		bm.ParseMode = ""
+	}
+}
+
+// ValidateAll returns all the failed rules joined with errors.Join.
+func (bm *BaseMessage) ValidateAll() error {
+	return errors.Join(bm.validationErrors()...)
+}
+
+type ReplyParameters struct {
+	MessageID                int64  `json:"message_id"`
+	ChatID                   int64  `json:"chat_id,omitempty"`
+	AllowSendingWithoutReply bool   `json:"allow_sending_without_reply,omitempty"`
+	Quote                    string `json:"quote,omitempty"`
+	QuotePosition            int    `json:"quote_position,omitempty"`
+}
+
+var (
+	ErrQuoteWithoutMessageID  = errors.New("quote without message_id")
+	ErrIncorrectQuotePosition = errors.New("incorrect quote_position")
+)
+
+func (rp *ReplyParameters) Validate() error {
+	if rp.MessageID < 0 {
+		return ErrIncorrectMessageID
+	}
+
+	if rp.MessageID == 0 {
+		if rp.Quote != "" {
+			return ErrQuoteWithoutMessageID
+		}
+
+		return ErrIncorrectMessageID
+	}
+
+	if rp.QuotePosition < 0 {
+		return ErrIncorrectQuotePosition
 	}
 
 	return nil
@@ -76,29 +296,85 @@ func (bm *BaseMessage) Validate() error {
 
 type SendMessage struct {
 	BaseMessage
-	MessageThreadID       int64 `json:"message_thread_id,omitempty"`
-	DisableWebPagePreview bool  `json:"disable_web_page_preview,omitempty"`
-	DisableNotification   bool  `json:"disable_notification,omitempty"`
-	ProtectContent        bool  `json:"protect_content,omitempty"`
+	MessageThreadID       int64  `json:"message_thread_id,omitempty"`
+	DisableWebPagePreview bool   `json:"disable_web_page_preview,omitempty"`
+	DisableNotification   bool   `json:"disable_notification,omitempty"`
+	ProtectContent        bool   `json:"protect_content,omitempty"`
+	MessageEffectID       string `json:"message_effect_id,omitempty" tg:"omitzero"`
+	ScheduleDate          int64  `json:"schedule_date,omitempty" tg:"experimental"`
+
+	ReplyParameters *ReplyParameters      `json:"reply_parameters,omitempty"`
+	ReplyMarkup     *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+
+	flags sendFlags
 }
 
-var ErrIncorrectMessageThreadID = errors.New("incorrect message_thread_id")
+var (
+	ErrIncorrectMessageEffectID = errors.New("incorrect message_effect_id")
+	ErrIncorrectScheduleDate    = errors.New("incorrect schedule_date")
+)
 
-func (sm *SendMessage) Validate() error {
-	if err := sm.BaseMessage.Validate(); err != nil {
-		return err
+func (sm *SendMessage) validationErrors() []error {
+	errs := sm.BaseMessage.validationErrors()
+
+	if err := validateMessageThreadID(sm.MessageThreadID); err != nil {
+		errs = append(errs, err)
 	}
 
-	if sm.MessageThreadID < 0 {
-		return ErrIncorrectMessageThreadID
+	if strings.ContainsFunc(sm.MessageEffectID, unicode.IsSpace) {
+		errs = append(errs, ErrIncorrectMessageEffectID)
 	}
 
-	return nil
+	if sm.ScheduleDate != 0 && sm.ScheduleDate <= time.Now().Unix() {
+		errs = append(errs, ErrIncorrectScheduleDate)
+	}
+
+	if sm.ReplyParameters != nil {
+		if err := sm.ReplyParameters.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if sm.ReplyMarkup != nil {
+		if err := sm.ReplyMarkup.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+func (sm *SendMessage) Validate() error {
+	return firstError(sm.validationErrors())
+}
+
+// ValidateAll returns all the failed rules joined with errors.Join.
+func (sm *SendMessage) ValidateAll() error {
+	return errors.Join(sm.validationErrors()...)
 }
 
 type SendOption func(*SendMessage)
 
+// sendFlags are the SendMessage settings which are not sent to the API.
+type sendFlags struct {
+	truncate      bool
+	onTruncate    func(size int)
+	plainText     bool
+	escape        bool
+	autoParseMode bool
+}
+
 func NewSendMessage(chatID int64, text string, opts ...SendOption) (*SendMessage, error) {
+	sm := newSendMessage(chatID, text, opts...)
+
+	if err := sm.Validate(); err != nil {
+		return nil, fmt.Errorf("SendMessage: %w", err)
+	}
+
+	return sm, nil
+}
+
+func newSendMessage(chatID int64, text string, opts ...SendOption) *SendMessage {
 	sm := new(SendMessage)
 
 	for _, opt := range opts {
@@ -108,16 +384,96 @@ func NewSendMessage(chatID int64, text string, opts ...SendOption) (*SendMessage
 	sm.ChatID = chatID
 	sm.Text = text
 
-	if err := sm.Validate(); err != nil {
-		return nil, fmt.Errorf("SendMessage: %w", err)
+	if sm.flags.autoParseMode {
+		sm.ParseMode = DetectParseMode(sm.Text)
+		sm.flags.plainText = sm.ParseMode == ""
 	}
 
-	return sm, nil
+	var escape ParseMode
+
+	if sm.flags.escape {
+		escape = sm.ParseMode
+	}
+
+	sm.Text = EscapeText(escape, text)
+
+	if sm.flags.truncate {
+		if size := utf16Len(sm.Text); size > MaxTextSize {
+			sm.Text = truncateEscapedText(escape, text, MaxTextSize)
+
+			if sm.flags.onTruncate != nil {
+				sm.flags.onTruncate(size)
+			}
+		}
+	}
+
+	return sm
 }
 
 func ParseModeSendOption(mode ParseMode) SendOption {
 	return func(sm *SendMessage) {
 		sm.ParseMode = mode
+		sm.flags.plainText = false
+		sm.flags.escape = false
+		sm.flags.autoParseMode = false
+	}
+}
+
+// AutoParseModeSendOption sets the parse mode detected in the text
+// (see DetectParseMode), the text without a markup is sent as plain text.
+// The detection is best-effort, set the parse mode explicitly if it's known.
+func AutoParseModeSendOption() SendOption {
+	return func(sm *SendMessage) {
+		sm.ParseMode = ""
+		sm.flags.plainText = false
+		sm.flags.escape = false
+		sm.flags.autoParseMode = true
+	}
+}
+
+// SafeTextSendOption sets the parse mode and escapes the whole text for it
+// (see EscapeText), so a plain string is shown as is.
+func SafeTextSendOption(mode ParseMode) SendOption {
+	return func(sm *SendMessage) {
+		sm.ParseMode = mode
+		sm.flags.plainText = false
+		sm.flags.escape = true
+		sm.flags.autoParseMode = false
+	}
+}
+
+// EntitiesSendOption formats the text by entities instead of a parse mode,
+// the text is sent as is (no auto detection or escaping of the parse mode).
+func EntitiesSendOption(entities ...MessageEntity) SendOption {
+	return func(sm *SendMessage) {
+		sm.Entities = entities
+		sm.flags.escape = false
+		sm.flags.autoParseMode = false
+	}
+}
+
+// MessageEffectIDSendOption adds the effect to the message, private chats only.
+func MessageEffectIDSendOption(id string) SendOption {
+	return func(sm *SendMessage) {
+		sm.MessageEffectID = id
+	}
+}
+
+func ReplyMarkupSendOption(markup *InlineKeyboardMarkup) SendOption {
+	return func(sm *SendMessage) {
+		sm.ReplyMarkup = markup
+	}
+}
+
+// PlainTextSendOption sends the text without parse_mode even if the client
+// has a default one (see WithDefaultParseMode), so the markup characters are
+// shown as is and the formatting can come only from the message entities.
+func PlainTextSendOption() SendOption {
+	return func(sm *SendMessage) {
+		sm.ParseMode = ""
+		sm.flags.plainText = true
+		sm.flags.escape = false
+		sm.flags.autoParseMode = false
 	}
 }
 
@@ -145,282 +501,1443 @@ func ProtectContentSendOption(protect bool) SendOption {
 	}
 }
 
+func ReplyParametersSendOption(params ReplyParameters) SendOption {
+	return func(sm *SendMessage) {
+		sm.ReplyParameters = &params
+	}
+}
+
+// ReplyToMessageIDSendOption is a shortcut for ReplyParametersSendOption
+// replying to a message in the same chat.
+func ReplyToMessageIDSendOption(messageID int64) SendOption {
+	return func(sm *SendMessage) {
+		if sm.ReplyParameters == nil {
+			sm.ReplyParameters = new(ReplyParameters)
+		}
+
+		sm.ReplyParameters.MessageID = messageID
+	}
+}
+
+// TruncateSendOption truncates the text to MaxTextSize with TruncateText
+// instead of failing with ErrTextTooLong.
+func TruncateSendOption(truncate bool) SendOption {
+	return func(sm *SendMessage) {
+		sm.flags.truncate = truncate
+	}
+}
+
+// OnTruncateSendOption sets the function called with the original text size
+// (in UTF-16 code units) when TruncateSendOption cuts the text, e.g. to count
+// the silently truncated messages. It's called when the message is built,
+// before it's sent.
+func OnTruncateSendOption(fn func(size int)) SendOption {
+	return func(sm *SendMessage) {
+		sm.flags.onTruncate = fn
+	}
+}
+
+// ScheduleDateSendOption schedules the message, it's not a part of the public
+// Bot API and is sent only by a client created with WithExperimentalFields.
+func ScheduleDateSendOption(date time.Time) SendOption {
+	return func(sm *SendMessage) {
+		sm.ScheduleDate = date.Unix()
+	}
+}
+
 type EditMessage struct {
 	MessageID int64 `json:"message_id"`
 	BaseMessage
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 }
 
 var ErrIncorrectMessageID = errors.New("incorrect message_id")
 
-func (em *EditMessage) Validate() error {
+func (em *EditMessage) validationErrors() []error {
+	var errs []error
+
 	if em.MessageID <= 0 {
-		return ErrIncorrectMessageID
+		errs = append(errs, ErrIncorrectMessageID)
+	}
+
+	errs = append(errs, em.BaseMessage.validationErrors()...)
+
+	if em.ReplyMarkup != nil {
+		if err := em.ReplyMarkup.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+func (em *EditMessage) Validate() error {
+	return firstError(em.validationErrors())
+}
+
+// ValidateAll returns all the failed rules joined with errors.Join.
+func (em *EditMessage) ValidateAll() error {
+	return errors.Join(em.validationErrors()...)
+}
+
+type EditOption func(*EditMessage)
+
+func NewEditMessage(chatID int64, messageID int64, text string, opts ...EditOption) (*EditMessage, error) {
+	em := newEditMessage(chatID, messageID, text, opts...)
+
+	if err := em.Validate(); err != nil {
+		return nil, fmt.Errorf("EditMessage: %w", err)
+	}
+
+	return em, nil
+}
+
+func newEditMessage(chatID int64, messageID int64, text string, opts ...EditOption) *EditMessage {
+	em := new(EditMessage)
+
+	for _, opt := range opts {
+		opt(em)
+	}
+
+	em.ChatID = chatID
+	em.MessageID = messageID
+	em.Text = text
+
+	return em
+}
+
+// EntitiesEditOption formats the text by entities instead of a parse mode.
+func EntitiesEditOption(entities ...MessageEntity) EditOption {
+	return func(em *EditMessage) {
+		em.Entities = entities
+	}
+}
+
+func ParseModeEditOption(mode ParseMode) EditOption {
+	return func(em *EditMessage) {
+		em.ParseMode = mode
+	}
+}
+
+// ReplyMarkupEditOption replaces the inline keyboard of the edited message,
+// the markup without buttons removes it.
+func ReplyMarkupEditOption(markup *InlineKeyboardMarkup) EditOption {
+	return func(em *EditMessage) {
+		em.ReplyMarkup = markup
+	}
+}
+
+type DeleteMessage struct {
+	ChatID    int64 `json:"chat_id"`
+	MessageID int64 `json:"message_id"`
+	BusinessConnection
+}
+
+func (dm *DeleteMessage) validationErrors() []error {
+	var errs []error
+
+	if dm.ChatID == 0 {
+		errs = append(errs, ErrEmptyChatID)
+	}
+
+	if dm.MessageID <= 0 {
+		errs = append(errs, ErrIncorrectMessageID)
+	}
+
+	if err := dm.BusinessConnection.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+func (dm *DeleteMessage) Validate() error {
+	return firstError(dm.validationErrors())
+}
+
+// ValidateAll returns all the failed rules joined with errors.Join.
+func (dm *DeleteMessage) ValidateAll() error {
+	return errors.Join(dm.validationErrors()...)
+}
+
+type DeleteOption func(*DeleteMessage)
+
+func NewDeleteMessage(chatID int64, messageID int64, opts ...DeleteOption) (*DeleteMessage, error) {
+	dm := newDeleteMessage(chatID, messageID, opts...)
+
+	if err := dm.Validate(); err != nil {
+		return nil, fmt.Errorf("DeleteMessage: %w", err)
+	}
+
+	return dm, nil
+}
+
+func newDeleteMessage(chatID int64, messageID int64, opts ...DeleteOption) *DeleteMessage {
+	dm := new(DeleteMessage)
+
+	for _, opt := range opts {
+		opt(dm)
+	}
+
+	dm.ChatID = chatID
+	dm.MessageID = messageID
+
+	return dm
+}
+
+type SendChatAction struct {
+	ChatID          int64      `json:"chat_id"`
+	MessageThreadID int64      `json:"message_thread_id,omitempty"`
+	Action          ChatAction `json:"action"`
+}
+
+func (sa *SendChatAction) Validate() error {
+	if sa.ChatID == 0 {
+		return ErrEmptyChatID
+	}
+
+	if err := validateMessageThreadID(sa.MessageThreadID); err != nil {
+		return err
+	}
+
+	return sa.Action.Validate()
+}
+
+type ChatActionOption func(*SendChatAction)
+
+func NewSendChatAction(chatID int64, action ChatAction, opts ...ChatActionOption) (*SendChatAction, error) {
+	sa := new(SendChatAction)
+
+	for _, opt := range opts {
+		opt(sa)
+	}
+
+	sa.ChatID = chatID
+	sa.Action = action
+
+	if err := sa.Validate(); err != nil {
+		return nil, fmt.Errorf("SendChatAction: %w", err)
+	}
+
+	return sa, nil
+}
+
+func MessageThreadIDChatActionOption(threadID int64) ChatActionOption {
+	return func(sa *SendChatAction) {
+		sa.MessageThreadID = threadID
+	}
+}
+
+type User struct {
+	ID        int64  `json:"id"`
+	FirstName string `json:"first_name"`
+	UserName  string `json:"username,omitempty"`
+}
+
+type Chat struct {
+	ID        int64  `json:"id"`
+	Type      string `json:"type"`
+	Title     string `json:"title,omitempty"`
+	UserName  string `json:"username,omitempty"`
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+}
+
+type Message struct {
+	MessageID       int64           `json:"message_id"`
+	MessageThreadID int64           `json:"message_thread_id,omitempty"`
+	From            *User           `json:"from,omitempty"`
+	Chat            Chat            `json:"chat"`
+	Date            int             `json:"date"` // unix time in seconds
+	Text            string          `json:"text,omitempty"`
+	Entities        []MessageEntity `json:"entities,omitempty"`
+	Caption         string          `json:"caption,omitempty"`
+	Video           *Video          `json:"video,omitempty"`
+	Voice           *Voice          `json:"voice,omitempty"`
+	Audio           *Audio          `json:"audio,omitempty"`
+	Sticker         *Sticker        `json:"sticker,omitempty"`
+	Animation       *Animation      `json:"animation,omitempty"`
+	Document        *Document       `json:"document,omitempty"`
+	Location        *Location       `json:"location,omitempty"`
+	Venue           *Venue          `json:"venue,omitempty"`
+}
+
+// Time returns Date as a time in UTC.
+func (m *Message) Time() time.Time {
+	return time.Unix(int64(m.Date), 0).UTC()
+}
+
+// Caller calls any API method (see Client.Call).
+type Caller interface {
+	Call(ctx context.Context, method string, params map[string]any, result any) error
+}
+
+// MessageSender sends the text, location and chat action messages.
+type MessageSender interface {
+	SendMessage(ctx context.Context, chatID int64, text string, opts ...SendOption) (*Message, error)
+	ForwardMessages(ctx context.Context,
+		chatID, fromChatID int64, messageIDs []int64, opts ...ForwardOption) ([]int64, error)
+	SendChatAction(ctx context.Context, chatID int64, action ChatAction, opts ...ChatActionOption) (bool, error)
+	SendLocation(ctx context.Context,
+		chatID int64, latitude, longitude float64, opts ...SendLocationOption) (*Message, error)
+	SendVenue(ctx context.Context,
+		chatID int64, latitude, longitude float64, title, address string, opts ...SendVenueOption) (*Message, error)
+}
+
+// MediaSender sends the files, the InputFile readers are streamed to the server
+// as multipart uploads, so these calls are never repeated.
+type MediaSender interface {
+	SendVideo(ctx context.Context, chatID int64, video InputFile, opts ...SendVideoOption) (*Message, error)
+	SendVoice(ctx context.Context, chatID int64, voice InputFile, opts ...SendVoiceOption) (*Message, error)
+	SendAudio(ctx context.Context, chatID int64, audio InputFile, opts ...SendAudioOption) (*Message, error)
+	SendSticker(ctx context.Context, chatID int64, sticker InputFile, opts ...SendStickerOption) (*Message, error)
+	SendAnimation(ctx context.Context,
+		chatID int64, animation InputFile, opts ...SendAnimationOption) (*Message, error)
+	SendDocument(ctx context.Context,
+		chatID int64, document InputFile, opts ...SendDocumentOption) (*Message, error)
+}
+
+// MessageEditor edits and deletes the sent messages.
+type MessageEditor interface {
+	EditMessage(ctx context.Context, chatID, messageID int64, text string, opts ...EditOption) (*Message, error)
+	DeleteMessage(ctx context.Context, chatID, messageID int64, opts ...DeleteOption) (bool, error)
+	EditMessageLiveLocation(ctx context.Context,
+		chatID, messageID int64, latitude, longitude float64, opts ...EditLiveLocationOption) (*Message, error)
+	StopMessageLiveLocation(ctx context.Context,
+		chatID, messageID int64, opts ...StopLiveLocationOption) (*Message, error)
+}
+
+// ChatReader gets the chat information.
+type ChatReader interface {
+	GetChat(ctx context.Context, chatID int64) (*Chat, error)
+	GetChatByUsername(ctx context.Context, username string) (*Chat, error)
+	GetChatAdministrators(ctx context.Context, chatID int64) ([]ChatMember, error)
+	GetChatMember(ctx context.Context, chatID, userID int64) (*ChatMember, error)
+}
+
+// ChatAdmin changes the chat settings and leaves the chats.
+type ChatAdmin interface {
+	SetChatTitle(ctx context.Context, chatID int64, title string) (bool, error)
+	SetChatDescription(ctx context.Context, chatID int64, description string) (bool, error)
+	SetChatPhoto(ctx context.Context, chatID int64, photo io.Reader, filename string) (bool, error)
+	DeleteChatPhoto(ctx context.Context, chatID int64) (bool, error)
+	LeaveChat(ctx context.Context, chatID int64) (bool, error)
+}
+
+// MemberAdmin manages the chat members, the join requests and the invite links.
+type MemberAdmin interface {
+	RevokeChatInviteLink(ctx context.Context, chatID int64, inviteLink string) (*ChatInviteLink, error)
+	ApproveChatJoinRequest(ctx context.Context, chatID, userID int64) (bool, error)
+	DeclineChatJoinRequest(ctx context.Context, chatID, userID int64) (bool, error)
+	PromoteChatMember(ctx context.Context, chatID, userID int64, opts ...PromoteOption) (bool, error)
+	RestrictChatMember(ctx context.Context,
+		chatID, userID int64, permissions ChatPermissions, opts ...RestrictOption) (bool, error)
+}
+
+// MessagePinner pins and unpins the chat messages.
+type MessagePinner interface {
+	PinChatMessage(ctx context.Context, chatID, messageID int64, opts ...PinOption) (bool, error)
+	UnpinAllChatMessages(ctx context.Context, chatID int64) (bool, error)
+}
+
+// FileDownloader downloads the files sent to the bot.
+type FileDownloader interface {
+	GetFile(ctx context.Context, fileID string) (*File, error)
+	DownloadFile(ctx context.Context, filePath string, w io.Writer) (int64, error)
+}
+
+// UpdatesGetter receives the incoming updates.
+type UpdatesGetter interface {
+	GetUpdates(ctx context.Context, opts ...GetUpdatesOption) ([]Update, error)
+}
+
+// BotProfile gets and sets the bot information.
+type BotProfile interface {
+	GetMe(ctx context.Context) (*User, error)
+	SetMyName(ctx context.Context, name string, opts ...BotProfileOption) (bool, error)
+	GetMyName(ctx context.Context, opts ...BotProfileOption) (string, error)
+	SetMyDescription(ctx context.Context, description string, opts ...BotProfileOption) (bool, error)
+	GetMyDescription(ctx context.Context, opts ...BotProfileOption) (string, error)
+	SetMyShortDescription(ctx context.Context, shortDescription string, opts ...BotProfileOption) (bool, error)
+	GetMyShortDescription(ctx context.Context, opts ...BotProfileOption) (string, error)
+}
+
+// TG is the union of the role interfaces, depend on the narrow ones
+// to mock only the used methods.
+type TG interface {
+	Caller
+	MessageSender
+	MediaSender
+	MessageEditor
+	ChatReader
+	ChatAdmin
+	MemberAdmin
+	MessagePinner
+	FileDownloader
+	UpdatesGetter
+	BotProfile
+}
+
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client is safe for concurrent use by multiple goroutines once created,
+// the options are applied only by NewClient and the shared state (dedup,
+// rate limits, chat cache) is guarded internally.
+type Client struct {
+	http               HTTPClient
+	log                *slog.Logger
+	endpoint           string
+	fileEndpoint       string
+	methodEndpoints    map[string]string
+	tokenSource        TokenSource
+	explicitDefaults   bool
+	maxResponseSize    int64
+	dedup              *dedup
+	ownHTTP            bool
+	experimental       bool
+	preferGET          bool
+	chatLimiter        *chatLimiter
+	chatCache          *chatCache
+	allowedChats       map[int64]struct{}
+	defaultChatID      int64
+	skipValidation     bool
+	baseCtx            context.Context //nolint:containedctx
+	methodTimeout      time.Duration
+	methodTimeouts     map[string]time.Duration
+	ignoreNotModified  bool
+	defaultParseMode   ParseMode
+	stats              stats
+	escapeHTML         bool
+	retries            int
+	maxRetryAfter      time.Duration
+	serverErrorRetries int
+	serverErrorBackoff time.Duration
+	compression        bool
+	me                 *User
+	dialContext        func(ctx context.Context, network, addr string) (net.Conn, error)
+	tlsConfig          *tls.Config
+	forceHTTP1         bool
+	disableKeepAlives  bool
+	strictChatID       bool
+	parseFallback      bool
+}
+
+var _ TG = (*Client)(nil)
+
+type Option func(*Client) error
+
+var (
+	ErrIncorrectScheme = errors.New("incorrect scheme")
+	ErrEmptyHost       = errors.New("empty host")
+)
+
+// serverURL validates the server base URL and returns it without the query,
+// the fragment and the trailing slash, the errors are prefixed with option.
+func serverURL(option, server string) (string, error) {
+	url, err := url.ParseRequestURI(server)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", option, err)
+	}
+
+	if url.Scheme != "http" && url.Scheme != "https" {
+		return "", fmt.Errorf("%s: url: %w", option, ErrIncorrectScheme)
+	}
+
+	if url.Host == "" {
+		return "", fmt.Errorf("%s: url: %w", option, ErrEmptyHost)
+	}
+
+	return url.Scheme + "://" + url.Host + strings.TrimRight(url.Path, "/"), nil
+}
+
+// WithAPIServer sets the API server base URL, it may contain a path
+// (e.g. "https://gw.internal/telegram"), query and fragment are dropped.
+// The files are downloaded from "<server>/file" unless WithFileServer is set.
+func WithAPIServer(server string) Option {
+	return func(cl *Client) error {
+		endpoint, err := serverURL("apiserver", server)
+		if err != nil {
+			return err
+		}
+
+		// keep the path for reverse-proxied deployments, "/bot<token>/" is appended to it
+		cl.endpoint = endpoint
+
+		return nil
+	}
+}
+
+// WithFileServer sets the base URL of the file downloads, "/bot<token>/<file_path>"
+// is appended to it (default "<api server>/file").
+func WithFileServer(server string) Option {
+	return func(cl *Client) error {
+		endpoint, err := serverURL("fileserver", server)
+		if err != nil {
+			return err
+		}
+
+		cl.fileEndpoint = endpoint
+
+		return nil
+	}
+}
+
+// WithMethodEndpoint sends the calls of method to server instead of the API
+// server, e.g. to route the heavy uploads to another gateway.
+func WithMethodEndpoint(method, server string) Option {
+	return func(cl *Client) error {
+		if method == "" {
+			return fmt.Errorf("methodendpoint: %w", ErrEmptyMethod)
+		}
+
+		endpoint, err := serverURL("methodendpoint", server)
+		if err != nil {
+			return err
+		}
+
+		if cl.methodEndpoints == nil {
+			cl.methodEndpoints = make(map[string]string)
+		}
+
+		cl.methodEndpoints[method] = endpoint
+
+		return nil
+	}
+}
+
+var ErrHTTPClientNil = errors.New("httpclient is nil")
+
+func WithHTTPClient(client HTTPClient) Option {
+	return func(cl *Client) error {
+		if client == nil {
+			return ErrHTTPClientNil
+		}
+
+		cl.http = client
+		cl.ownHTTP = false
+
+		return nil
+	}
+}
+
+var ErrTransportNil = errors.New("transport is nil")
+
+// WithTransport makes the client use its own http.Client
+// with the given transport and the default timeout.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(cl *Client) error {
+		if transport == nil {
+			return ErrTransportNil
+		}
+
+		cl.http = &http.Client{
+			Timeout:   defaultHTTPClient.Timeout,
+			Transport: transport,
+		}
+		cl.ownHTTP = true
+
+		return nil
+	}
+}
+
+var (
+	ErrDialContextNil        = errors.New("dial context is nil")
+	ErrDialContextHTTPClient = errors.New("dial context can't be used with http client")
+)
+
+// WithDialContext sets the function dialing the connections of the default
+// transport or the *http.Transport given to WithTransport (e.g. to pin DNS
+// or force IPv4), it fails with an HTTPClient set by WithHTTPClient.
+func WithDialContext(dial func(ctx context.Context, network, addr string) (net.Conn, error)) Option {
+	return func(cl *Client) error {
+		if dial == nil {
+			return ErrDialContextNil
+		}
+
+		cl.dialContext = dial
+
+		return nil
+	}
+}
+
+func (c *Client) applyDialContext() error {
+	return c.applyTransport(ErrDialContextHTTPClient, func(transport *http.Transport) {
+		transport.DialContext = c.dialContext
+	})
+}
+
+var (
+	ErrTLSConfigNil        = errors.New("tls config is nil")
+	ErrTLSConfigHTTPClient = errors.New("tls config can't be used with http client")
+)
+
+// WithTLSConfig sets the TLS configuration of the default transport or the
+// *http.Transport given to WithTransport, it fails with an HTTPClient set by
+// WithHTTPClient.
+func WithTLSConfig(config *tls.Config) Option {
+	return func(cl *Client) error {
+		if config == nil {
+			return ErrTLSConfigNil
+		}
+
+		cl.tlsConfig = config.Clone()
+
+		return nil
+	}
+}
+
+// WithInsecureSkipVerify disables the verification of the server certificate
+// (e.g. a local Bot API server with a self-signed certificate).
+//
+// WARNING: the connection is open to man-in-the-middle attacks and the bot
+// token can be stolen, never use it with api.telegram.org or over a network
+// you don't control. Prefer WithTLSConfig with the server certificate in RootCAs.
+func WithInsecureSkipVerify(skip bool) Option {
+	return func(cl *Client) error {
+		if cl.tlsConfig == nil {
+			if !skip {
+				return nil
+			}
+
+			//nolint:gosec
+			cl.tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+
+		cl.tlsConfig.InsecureSkipVerify = skip
+
+		return nil
+	}
+}
+
+func (c *Client) applyTLSConfig() error {
+	return c.applyTransport(ErrTLSConfigHTTPClient, func(transport *http.Transport) {
+		transport.TLSClientConfig = c.tlsConfig
+	})
+}
+
+var ErrConnectionHTTPClient = errors.New("connection options can't be used with http client")
+
+// WithForceHTTP1 makes the default transport or the *http.Transport given to
+// WithTransport use only HTTP/1.1, it fails with an HTTPClient set by
+// WithHTTPClient.
+func WithForceHTTP1(force bool) Option {
+	return func(cl *Client) error {
+		cl.forceHTTP1 = force
+
+		return nil
+	}
+}
+
+// WithDisableKeepAlives makes the default transport or the *http.Transport
+// given to WithTransport open a new connection for every request, it fails
+// with an HTTPClient set by WithHTTPClient.
+func WithDisableKeepAlives(disable bool) Option {
+	return func(cl *Client) error {
+		cl.disableKeepAlives = disable
+
+		return nil
+	}
+}
+
+func (c *Client) applyConnection() error {
+	return c.applyTransport(ErrConnectionHTTPClient, func(transport *http.Transport) {
+		if c.forceHTTP1 {
+			transport.ForceAttemptHTTP2 = false
+			// a non-nil empty map disables HTTP/2 over TLS
+			transport.TLSNextProto = make(map[string]func(string, *tls.Conn) http.RoundTripper)
+		}
+
+		transport.DisableKeepAlives = c.disableKeepAlives
+	})
+}
+
+// applyTransport replaces the http.Client owned by the client with a copy
+// whose *http.Transport is changed by set, errHTTPClient is returned when the
+// client doesn't own the HTTPClient or its transport isn't a *http.Transport.
+func (c *Client) applyTransport(errHTTPClient error, set func(transport *http.Transport)) error {
+	if c.http != nil && !c.ownHTTP {
+		return errHTTPClient
+	}
+
+	client := defaultHTTPClient
+	if c.http != nil {
+		client, _ = c.http.(*http.Client)
+	}
+
+	transport, ok := client.Transport.(*http.Transport)
+	if !ok {
+		return errHTTPClient
+	}
+
+	transport = transport.Clone()
+	set(transport)
+
+	c.http = &http.Client{
+		Timeout:   client.Timeout,
+		Transport: transport,
+	}
+	c.ownHTTP = true
+
+	return nil
+}
+
+var ErrExperimentalFields = errors.New("experimental fields are disabled")
+
+var ErrLoggerNil = errors.New("logger is nil")
+
+func WithLogger(log *slog.Logger) Option {
+	return func(cl *Client) error {
+		if log == nil {
+			return ErrLoggerNil
+		}
+
+		cl.log = log
+
+		return nil
+	}
+}
+
+// WithExperimentalFields allows the fields supported only by extended or
+// self-hosted API servers (e.g. schedule_date), the public API rejects them.
+func WithExperimentalFields(enable bool) Option {
+	return func(cl *Client) error {
+		cl.experimental = enable
+
+		return nil
+	}
+}
+
+// WithPreferGET makes the methods without parameters (e.g. getMe) use GET,
+// the methods with parameters always use POST.
+func WithPreferGET(prefer bool) Option {
+	return func(cl *Client) error {
+		cl.preferGET = prefer
+
+		return nil
+	}
+}
+
+// WithParseFallback makes SendMessage repeat once the message rejected with
+// "can't parse entities" without parse_mode, so it's delivered unformatted
+// instead of lost, the fallback is logged as a warning (see WithLogger).
+func WithParseFallback(fallback bool) Option {
+	return func(cl *Client) error {
+		cl.parseFallback = fallback
+
+		return nil
+	}
+}
+
+// WithIgnoreNotModified makes EditMessage treat the "message is not modified"
+// error (the new text equals the current one) as success, the returned message
+// is built from the request (only MessageID, Chat.ID and Text are set).
+func WithIgnoreNotModified(ignore bool) Option {
+	return func(cl *Client) error {
+		cl.ignoreNotModified = ignore
+
+		return nil
+	}
+}
+
+// WithDefaultParseMode sets the parse mode of SendMessage calls without
+// ParseModeSendOption, use PlainTextSendOption to send a message without it.
+func WithDefaultParseMode(mode ParseMode) Option {
+	return func(cl *Client) error {
+		if err := mode.Validate(); err != nil {
+			return err
+		}
+
+		cl.defaultParseMode = mode
+
+		return nil
+	}
+}
+
+// WithEscapeHTML makes the requests escape <, > and & in strings as \u003c,
+// \u003e and \u0026 (the encoding/json default). By default the characters are
+// sent as is, so the HTML parse mode text is readable in the request body.
+func WithEscapeHTML(escape bool) Option {
+	return func(cl *Client) error {
+		cl.escapeHTML = escape
+
+		return nil
+	}
+}
+
+// WithCompression asks the server for gzip compressed responses and decompresses
+// them, useful with an HTTPClient whose transport doesn't do it by itself.
+// The gzip responses are decompressed even without the option.
+func WithCompression(enable bool) Option {
+	return func(cl *Client) error {
+		cl.compression = enable
+
+		return nil
+	}
+}
+
+// WithSkipValidation disables the local validation in SendMessage,
+// EditMessage and DeleteMessage, the API server becomes the only authority.
+//
+// Warning: invalid requests (empty chat id, too long text, unknown parse mode)
+// are sent as is and cost an API call to be rejected, use only with servers
+// supporting more than this client knows about.
+func WithSkipValidation(skip bool) Option {
+	return func(cl *Client) error {
+		cl.skipValidation = skip
+
+		return nil
+	}
+}
+
+type validator interface {
+	Validate() error
+}
+
+func (c *Client) validateRequest(req validator) error {
+	if c.skipValidation {
+		return nil
+	}
+
+	return req.Validate() //nolint:wrapcheck
+}
+
+// WithExplicitDefaults makes requests serialize zero-valued optional fields
+// (e.g. "parse_mode":"" or "disable_notification":false) instead of omitting them.
+// Nil pointers, slices and maps are still omitted.
+func WithExplicitDefaults(explicit bool) Option {
+	return func(cl *Client) error {
+		cl.explicitDefaults = explicit
+
+		return nil
+	}
+}
+
+const defaultMaxResponseSize int64 = 10 << 20
+
+var ErrIncorrectMaxResponseSize = errors.New("incorrect max response size")
+
+// WithMaxResponseSize limits the size of the response body read by API,
+// bigger responses fail with ErrResponseTooLarge (default 10 MB).
+func WithMaxResponseSize(size int64) Option {
+	return func(cl *Client) error {
+		if size <= 0 {
+			return ErrIncorrectMaxResponseSize
+		}
+
+		cl.maxResponseSize = size
+
+		return nil
+	}
+}
+
+type requestIDKey struct{}
+
+// WithRequestID returns a copy of ctx carrying the request id,
+// which is attached to the logs of every API call made with it.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+
+	return id
+}
+
+const defaultAPIServer = "https://api.telegram.org"
+
+//nolint:gomnd,gochecknoglobals
+var defaultHTTPClient = &http.Client{
+	Timeout: 2 * time.Second,
+	Transport: &http.Transport{
+		MaxIdleConns:    10,
+		IdleConnTimeout: 10 * time.Second,
+	},
+}
+
+var regexpToken = regexp.MustCompile(`^([\d]+):([\d\w\-]+)$`)
+
+var ErrIncorrentToken = errors.New("incorrect token")
+
+// NewClient creates the client with the static token, or with an empty token
+// and the token source given by WithTokenSource.
+func NewClient(token string, options ...Option) (*Client, error) {
+	client := new(Client)
+	client.endpoint = defaultAPIServer
+
+	for _, opt := range options {
+		if err := opt(client); err != nil {
+			return nil, fmt.Errorf("Client: %w", err)
+		}
+	}
+
+	if client.tokenSource == nil || token != "" {
+		if client.tokenSource != nil || !regexpToken.MatchString(token) {
+			return nil, fmt.Errorf("Client: %w", ErrIncorrentToken)
+		}
+
+		client.tokenSource = StaticTokenSource(token)
+	}
+
+	if client.dialContext != nil {
+		if err := client.applyDialContext(); err != nil {
+			return nil, fmt.Errorf("Client: %w", err)
+		}
+	}
+
+	if client.tlsConfig != nil {
+		if err := client.applyTLSConfig(); err != nil {
+			return nil, fmt.Errorf("Client: %w", err)
+		}
+	}
+
+	if client.forceHTTP1 || client.disableKeepAlives {
+		if err := client.applyConnection(); err != nil {
+			return nil, fmt.Errorf("Client: %w", err)
+		}
+	}
+
+	if client.http == nil {
+		// a copy of the default one, so Close doesn't touch the shared transport
+		transport, _ := defaultHTTPClient.Transport.(*http.Transport)
+
+		client.http = &http.Client{
+			Timeout:   defaultHTTPClient.Timeout,
+			Transport: transport.Clone(),
+		}
+		client.ownHTTP = true
+	}
+
+	if client.fileEndpoint == "" {
+		client.fileEndpoint = client.endpoint + "/file"
+	}
+
+	return client, nil
+}
+
+// NewClientVerified creates the client like NewClient and calls getMe to check
+// the token is accepted by the server, the bot user is available with Me.
+func NewClientVerified(ctx context.Context, token string, opts ...Option) (*Client, error) {
+	client, err := NewClient(token, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	me, err := client.GetMe(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Client: %w", err)
+	}
+
+	client.me = me
+
+	return client, nil
+}
+
+// Me returns the bot user received by NewClientVerified, otherwise nil.
+func (c *Client) Me() *User {
+	return c.me
+}
+
+// Close releases the idle connections of the HTTP client owned by the client
+// (a copy of the default one or created by WithTransport), an HTTPClient injected with
+// WithHTTPClient is left untouched. Calling Close is optional.
+func (c *Client) Close() error {
+	if !c.ownHTTP {
+		return nil
+	}
+
+	if client, ok := c.http.(*http.Client); ok {
+		client.CloseIdleConnections()
+	}
+
+	return nil
+}
+
+type Response struct {
+	Result interface{} `json:"result,omitempty"`
+	ResponseError
+}
+
+// ResponseParameters explains why a request failed and how to fix it, a successful
+// response may carry them too (see APIWithMeta).
+type ResponseParameters struct {
+	// MigrateToChatID is the new id of the group migrated to a supergroup.
+	MigrateToChatID int64 `json:"migrate_to_chat_id,omitempty"`
+	// RetryAfter is the number of seconds to wait before repeating the request.
+	RetryAfter int `json:"retry_after,omitempty"`
+}
+
+// UnmarshalJSON accepts a fractional retry_after returned by some
+// API-compatible servers, it is rounded up to whole seconds.
+func (rp *ResponseParameters) UnmarshalJSON(data []byte) error {
+	var params struct {
+		MigrateToChatID int64   `json:"migrate_to_chat_id"`
+		RetryAfter      float64 `json:"retry_after"`
+	}
+
+	if err := json.Unmarshal(data, &params); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	rp.MigrateToChatID = params.MigrateToChatID
+	rp.RetryAfter = int(math.Ceil(params.RetryAfter))
+
+	return nil
+}
+
+// ResponseError is the error returned by the API server, the methods return it
+// wrapped, use errors.As to get the code and the parameters.
+type ResponseError struct {
+	Ok          bool               `json:"ok"`
+	ErrorCode   int                `json:"error_code,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Parameters  ResponseParameters `json:"parameters,omitempty"`
+}
+
+func (r ResponseError) Error() string {
+	return r.Description
+}
+
+var (
+	ErrValueNil             = errors.New("value is nil")
+	ErrValueNotPtr          = errors.New("value not ptr")
+	ErrValueNotStructOrBool = errors.New("value not struct, slice, map or bool")
+)
+
+func validate(v any) error {
+	if v == nil {
+		return ErrValueNil
+	}
+
+	value := reflect.ValueOf(v)
+
+	if value.Type().Kind() != reflect.Ptr {
+		return ErrValueNotPtr
+	}
+
+	if value.Type().Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+
+	switch value.Kind() { //nolint:exhaustive
+	case reflect.Struct, reflect.Slice, reflect.Map, reflect.Bool:
+		return nil
+	default:
+		return ErrValueNotStructOrBool
+	}
+}
+
+func (c *Client) marshal(req any) ([]byte, error) {
+	if msg, ok := req.(interface{ beforeMarshal() }); ok {
+		msg.beforeMarshal()
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(req))
+
+	if c.explicitDefaults && value.Kind() == reflect.Struct {
+		fields := make(map[string]any)
+
+		explicitFields(value, fields)
+
+		req = fields
+	}
+
+	buf := new(bytes.Buffer)
+
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(c.escapeHTML)
+
+	if err := enc.Encode(req); err != nil {
+		return nil, err //nolint:wrapcheck
 	}
 
-	return em.BaseMessage.Validate()
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
 }
 
-type EditOption func(*EditMessage)
+func explicitFields(value reflect.Value, fields map[string]any) {
+	for i := range value.NumField() {
+		field := value.Type().Field(i)
 
-func NewEditMessage(chatID int64, messageID int64, text string, opts ...EditOption) (*EditMessage, error) {
-	em := new(EditMessage)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
 
-	for _, opt := range opts {
-		opt(em)
-	}
+		name, _, _ := strings.Cut(tag, ",")
+		fieldValue := value.Field(i)
 
-	em.ChatID = chatID
-	em.MessageID = messageID
-	em.Text = text
+		if field.Anonymous && name == "" && fieldValue.Kind() == reflect.Struct {
+			explicitFields(fieldValue, fields)
 
-	if err := em.Validate(); err != nil {
-		return nil, fmt.Errorf("EditMessage: %w", err)
-	}
+			continue
+		}
 
-	return em, nil
-}
+		if !field.IsExported() {
+			continue
+		}
 
-func ParseModeEditOption(mode ParseMode) EditOption {
-	return func(em *EditMessage) {
-		em.ParseMode = mode
+		// fields tagged with tg:"omitzero" or tg:"experimental" are never sent as explicit defaults
+		if field.Tag.Get("tg") != "" && fieldValue.IsZero() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		switch fieldValue.Kind() { //nolint:exhaustive
+		case reflect.Pointer, reflect.Interface, reflect.Slice, reflect.Map:
+			if fieldValue.IsNil() {
+				continue
+			}
+		}
+
+		fields[name] = fieldValue.Interface()
 	}
 }
 
-type DeleteMessage struct {
-	ChatID    int64 `json:"chat_id"`
-	MessageID int64 `json:"message_id"`
+var (
+	ErrUnexpectedStatus = errors.New("unexpected status")
+	ErrResponseTooLarge = errors.New("response too large")
+)
+
+// statusError is the status and the body snippet of a response which is not
+// an API response, e.g. an error page of a proxy.
+type statusError struct {
+	code int
+	body string
 }
 
-func (dm *DeleteMessage) Validate() error {
-	if dm.ChatID == 0 {
-		return ErrEmptyChatID
-	}
+func (se *statusError) Error() string {
+	return fmt.Sprintf("%d: %q", se.code, se.body)
+}
 
-	if dm.MessageID <= 0 {
-		return ErrIncorrectMessageID
-	}
+var regexpEndpointToken = regexp.MustCompile(`/bot[^/]+/`)
 
-	return nil
+// redactToken hides the bot token in the url of an API method.
+func redactToken(url string) string {
+	return regexpEndpointToken.ReplaceAllString(url, "/bot<token>/")
 }
 
-func NewDeleteMessage(chatID int64, messageID int64) (*DeleteMessage, error) {
-	dm := new(DeleteMessage)
-
-	dm.ChatID = chatID
-	dm.MessageID = messageID
+// redactError hides the bot token in the url of a transport error.
+func redactError(err error) error {
+	var urlErr *url.Error
 
-	if err := dm.Validate(); err != nil {
-		return nil, fmt.Errorf("DeleteMessage: %w", err)
+	if errors.As(err, &urlErr) {
+		urlErr.URL = redactToken(urlErr.URL)
 	}
 
-	return dm, nil
+	return err
 }
 
-type User struct {
-	ID        int64  `json:"id"`
-	FirstName string `json:"first_name"`
-	UserName  string `json:"username,omitempty"`
-}
+const maxSnippetSize = 256
 
-type Message struct {
-	MessageID int64 `json:"message_id"`
-	Date      int   `json:"date"`
-}
+func snippet(body []byte) string {
+	if len(body) > maxSnippetSize {
+		return string(body[:maxSnippetSize]) + "..."
+	}
 
-type TG interface {
-	GetMe(ctx context.Context) (*User, error)
-	SendMessage(ctx context.Context, chatID int64, text string, opts ...SendOption) (*Message, error)
-	EditMessage(ctx context.Context, chatID, messageID int64, text string, opts ...EditOption) (*Message, error)
-	DeleteMessage(ctx context.Context, chatID, messageID int64) (bool, error)
+	return string(body)
 }
 
-type HTTPClient interface {
-	Do(req *http.Request) (*http.Response, error)
+func (c *Client) API(ctx context.Context, method string, req, resp any) error {
+	return c.apiResponse(ctx, method, req, &Response{Result: resp})
 }
 
-type Client struct {
-	http     HTTPClient
-	endpoint string
+// APIWithMeta calls the method like API and returns the whole response, e.g.
+// to read the parameters of a successful response, its Result is resp.
+func (c *Client) APIWithMeta(ctx context.Context, method string, req, resp any) (*Response, error) {
+	respBody := &Response{Result: resp}
+
+	if err := c.apiResponse(ctx, method, req, respBody); err != nil {
+		return nil, err
+	}
+
+	return respBody, nil
 }
 
-var _ TG = (*Client)(nil)
+func (c *Client) apiResponse(ctx context.Context, method string, req any, respBody *Response) error {
+	ctx, cancelTimeout := c.withMethodTimeout(ctx, method)
+	defer cancelTimeout()
 
-type Option func(*Client) error
+	ctx, cancel := c.withBaseContext(ctx)
+	defer cancel()
 
-var (
-	ErrIncorrectScheme = errors.New("incorrect scheme")
-	ErrEmptyHost       = errors.New("empty host")
-)
+	retries := new(retryState)
 
-func WithAPIServer(server string) Option {
-	return func(cl *Client) error {
-		url, err := url.ParseRequestURI(server)
-		if err != nil {
-			return fmt.Errorf("apiserver: %w", err)
-		}
+	for {
+		err := c.call(ctx, method, req, respBody)
 
-		if url.Scheme != "http" && url.Scheme != "https" {
-			return fmt.Errorf("apiserver: url: %w", ErrIncorrectScheme)
+		delay, ok := c.retryDelay(req, err, retries)
+		if !ok {
+			return err
 		}
 
-		if url.Host == "" {
-			return fmt.Errorf("apiserver: url: %w", ErrEmptyHost)
+		if sleepErr := sleep(ctx, delay); sleepErr != nil {
+			return err
 		}
 
-		cl.endpoint = server
-
-		return nil
+		c.stats.retries.Add(1)
 	}
 }
 
-var ErrHTTPClientNil = errors.New("httpclient is nil")
-
-func WithHTTPClient(client HTTPClient) Option {
-	return func(cl *Client) error {
-		if client == nil {
-			return ErrHTTPClientNil
-		}
-
-		cl.http = client
+// callBool calls the method which returns True on success.
+func (c *Client) callBool(ctx context.Context, method string, req any) (bool, error) {
+	resp := false
 
-		return nil
+	if err := c.API(ctx, method, req, &resp); err != nil {
+		return false, err
 	}
-}
 
-const defaultAPIServer = "https://api.telegram.org"
-
-//nolint:gomnd,gochecknoglobals
-var defaultHTTPClient = &http.Client{
-	Timeout: 2 * time.Second,
-	Transport: &http.Transport{
-		MaxIdleConns:    10,
-		IdleConnTimeout: 10 * time.Second,
-	},
+	return resp, nil
 }
 
-var regexpToken = regexp.MustCompile(`^([\d]+):([\d\w\-]+)$`)
+func (c *Client) call(ctx context.Context, method string, req any, respBody *Response) error {
+	start := time.Now()
 
-var ErrIncorrentToken = errors.New("incorrect token")
+	err := c.api(ctx, method, req, respBody)
 
-func NewClient(token string, options ...Option) (*Client, error) {
-	if !regexpToken.MatchString(token) {
-		return nil, fmt.Errorf("Client: %w", ErrIncorrentToken)
-	}
+	c.stats.request(err)
 
-	client := new(Client)
-	client.endpoint = defaultAPIServer
+	if c.log != nil {
+		attrs := []slog.Attr{
+			slog.String("method", method),
+			slog.Duration("duration", time.Since(start)),
+		}
 
-	for _, opt := range options {
-		if err := opt(client); err != nil {
-			return nil, fmt.Errorf("Client: %w", err)
+		if id := RequestIDFromContext(ctx); id != "" {
+			attrs = append(attrs, slog.String("request_id", id))
 		}
+
+		if err != nil {
+			attrs = append(attrs, slog.String("error", err.Error()))
+		}
+
+		c.log.LogAttrs(ctx, slog.LevelDebug, "API call", attrs...)
 	}
 
-	if client.http == nil {
-		client.http = defaultHTTPClient
+	return err
+}
+
+// multipart returns the multipart/form-data body of req streamed by a goroutine
+// through a pipe, so the files are not buffered in memory. A read error of a file
+// fails the request, the goroutine ends when the body is read or closed.
+func (c *Client) multipart(req any, files []uploadFile) (io.ReadCloser, string, error) {
+	body, err := c.marshal(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("json: %w", err)
 	}
 
-	client.endpoint += "/bot" + token + "/"
+	fields := make(map[string]json.RawMessage)
 
-	return client, nil
-}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, "", fmt.Errorf("json: %w", err)
+	}
 
-type Response struct {
-	Result interface{} `json:"result,omitempty"`
-	ResponseError
-}
+	reader, writer := io.Pipe()
+	form := multipart.NewWriter(writer)
 
-type ResponseError struct {
-	Ok          bool   `json:"ok"`
-	ErrorCode   int    `json:"error_code,omitempty"`
-	Description string `json:"description,omitempty"`
-	Parameters  struct {
-		RetryAfter int `json:"retry_after,omitempty"`
-	} `json:"parameters,omitempty"`
-}
+	go func() {
+		writer.CloseWithError(writeMultipart(form, fields, files))
+	}()
 
-func (r ResponseError) Error() string {
-	return r.Description
+	return reader, form.FormDataContentType(), nil
 }
 
-var (
-	ErrValueNil             = errors.New("value is nil")
-	ErrValueNotPtr          = errors.New("value not ptr")
-	ErrValueNotStructOrBool = errors.New("value not struct or bool")
-)
+func writeMultipart(form *multipart.Writer, fields map[string]json.RawMessage, files []uploadFile) error {
+	for name, raw := range fields {
+		if string(raw) == "null" {
+			continue
+		}
 
-func validate(v any) error {
-	if v == nil {
-		return ErrValueNil
-	}
+		value := string(raw)
 
-	value := reflect.ValueOf(v)
+		var str string
+		if err := json.Unmarshal(raw, &str); err == nil {
+			value = str
+		}
 
-	if value.Type().Kind() != reflect.Ptr {
-		return ErrValueNotPtr
+		if err := form.WriteField(name, value); err != nil {
+			return fmt.Errorf("multipart: %w", err)
+		}
 	}
 
-	if value.Type().Kind() == reflect.Ptr {
-		value = value.Elem()
+	for _, file := range files {
+		part, err := form.CreateFormFile(file.field, file.name)
+		if err != nil {
+			return fmt.Errorf("multipart: %w", err)
+		}
+
+		if _, err := io.Copy(part, file.reader); err != nil {
+			return fmt.Errorf("multipart: %w", err)
+		}
 	}
 
-	if value.Kind() != reflect.Struct && value.Kind() != reflect.Bool {
-		return ErrValueNotStructOrBool
+	if err := form.Close(); err != nil {
+		return fmt.Errorf("multipart: %w", err)
 	}
 
 	return nil
 }
 
-func (c *Client) API(ctx context.Context, method string, req, resp any) error {
-	var reqBody io.Reader
+func (c *Client) api(ctx context.Context, method string, req any, respBody *Response) error {
+	var (
+		reqBody io.Reader
+		payload string
+	)
+
+	contentType := "application/json"
 
 	if req != nil {
 		if err := validate(req); err != nil {
 			return fmt.Errorf("validate: req %w", err)
 		}
 
-		body, err := json.Marshal(req)
-		if err != nil {
-			return fmt.Errorf("request: json: %w", err)
+		if files := inputFiles(req); len(files) > 0 {
+			body, bodyType, err := c.multipart(req, files)
+			if err != nil {
+				return fmt.Errorf("request: %w", err)
+			}
+
+			// the transport closes the body, but not every HTTPClient does,
+			// closing it again stops the writing goroutine
+			defer body.Close()
+
+			reqBody = body
+			contentType = bodyType
+			payload = bodyType
+		} else {
+			body, err := c.marshal(req)
+			if err != nil {
+				return fmt.Errorf("request: json: %w", err)
+			}
+
+			reqBody = bytes.NewReader(body)
+			payload = snippet(body)
 		}
-
-		reqBody = bytes.NewReader(body)
 	}
 
-	if err := validate(resp); err != nil {
+	if err := validate(respBody.Result); err != nil {
 		return fmt.Errorf("validate: resp %w", err)
 	}
 
-	url := c.endpoint + method
+	endpoint, ok := c.methodEndpoints[method]
+	if !ok {
+		endpoint = c.endpoint
+	}
+
+	url, err := c.botURL(ctx, endpoint, method)
+	if err != nil {
+		return err
+	}
+
+	httpMethod := http.MethodPost
+	if req == nil && c.preferGET {
+		httpMethod = http.MethodGet
+	}
 
-	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, reqBody)
+	httpReq, err := http.NewRequestWithContext(ctx, httpMethod, url, reqBody)
 	if err != nil {
-		return fmt.Errorf("request: %w", err)
+		return fmt.Errorf("request: %w", redactError(err))
+	}
+
+	if httpMethod == http.MethodPost {
+		httpReq.Header.Add("Content-Type", contentType)
 	}
 
-	httpReq.Header.Add("Content-Type", "application/json")
+	if c.compression {
+		httpReq.Header.Set("Accept-Encoding", "gzip")
+	}
 
 	httpResp, err := c.http.Do(httpReq)
 	if err != nil {
-		return fmt.Errorf("request: %w", err)
+		return fmt.Errorf("request: %w", redactError(err))
 	}
 
 	defer httpResp.Body.Close()
 
-	respBody := new(Response)
-	respBody.Result = resp
+	if c.log != nil {
+		attrs := []slog.Attr{
+			slog.String("http_method", httpMethod),
+			slog.String("url", redactToken(url)),
+			slog.String("payload", payload),
+			slog.Int("status", httpResp.StatusCode),
+		}
+
+		if id := RequestIDFromContext(ctx); id != "" {
+			attrs = append(attrs, slog.String("request_id", id))
+		}
+
+		c.log.LogAttrs(ctx, slog.LevelDebug, "API request", attrs...)
+	}
+
+	body := io.Reader(httpResp.Body)
+
+	// the transport decompresses only the responses to the requests without
+	// Accept-Encoding, set by WithCompression or a proxy ignoring it
+	if strings.EqualFold(httpResp.Header.Get("Content-Encoding"), "gzip") {
+		gzipBody, err := gzip.NewReader(httpResp.Body)
+		if err != nil {
+			return fmt.Errorf("response: gzip: %w", err)
+		}
+
+		defer gzipBody.Close()
 
-	if err := json.NewDecoder(httpResp.Body).Decode(respBody); err != nil {
+		body = gzipBody
+	}
+
+	// the response of a retried request is decoded again
+	respBody.ResponseError = ResponseError{}
+
+	maxSize := c.maxResponseSize
+	if maxSize <= 0 {
+		maxSize = defaultMaxResponseSize
+	}
+
+	limited := &io.LimitedReader{R: body, N: maxSize + 1}
+
+	raw, err := io.ReadAll(limited)
+	if err != nil {
+		return fmt.Errorf("response: json: %w", err)
+	}
+
+	if limited.N <= 0 {
+		return fmt.Errorf("response: %w", ErrResponseTooLarge)
+	}
+
+	// an error of a proxy or a load balancer isn't a Telegram response,
+	// even if it's a JSON
+	if httpResp.StatusCode >= http.StatusMultipleChoices && !isResponse(raw) {
+		return fmt.Errorf("response: %w: %w", ErrUnexpectedStatus,
+			&statusError{code: httpResp.StatusCode, body: snippet(raw)})
+	}
+
+	if err := json.NewDecoder(bytes.NewReader(raw)).Decode(respBody); err != nil {
 		return fmt.Errorf("response: json: %w", err)
 	}
 
 	if !respBody.Ok {
+		if respBody.ErrorCode == 0 {
+			respBody.ErrorCode = httpResp.StatusCode
+		}
+
 		return fmt.Errorf("response: %w", respBody.ResponseError)
 	}
 
 	return nil
 }
 
+// isResponse reports whether body is a Telegram response object (with "ok").
+func isResponse(body []byte) bool {
+	var resp struct {
+		Ok *bool `json:"ok"`
+	}
+
+	return json.Unmarshal(body, &resp) == nil && resp.Ok != nil
+}
+
 const getMeMethod = "getMe"
 
 func (c *Client) GetMe(ctx context.Context) (*User, error) {
@@ -438,17 +1955,82 @@ const sendMessageMethod = "sendMessage"
 func (c *Client) SendMessage(ctx context.Context,
 	chatID int64, text string, opts ...SendOption,
 ) (*Message, error) {
-	req, err := NewSendMessage(chatID, text, opts...)
-	if err != nil {
+	req := newSendMessage(chatID, text, opts...)
+
+	if req.ParseMode == "" && !req.flags.plainText && len(req.Entities) == 0 {
+		req.ParseMode = c.defaultParseMode
+	}
+
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("SendMessage: %w", err)
+	}
+
+	if req.ScheduleDate != 0 && !c.experimental {
+		return nil, fmt.Errorf("SendMessage: schedule_date: %w", ErrExperimentalFields)
+	}
+
+	var key dedupKey
+
+	if c.dedup != nil {
+		key = newDedupKey(req)
+
+		if msg, ok := c.dedup.get(key); ok {
+			return msg, nil
+		}
+	}
+
+	if err := c.allowChat(chatID); err != nil {
+		return nil, fmt.Errorf("SendMessage: %w", err)
+	}
+
+	if err := c.waitChat(ctx, chatID); err != nil {
 		return nil, fmt.Errorf("SendMessage: %w", err)
 	}
 
 	resp := new(Message)
 
-	if err := c.API(ctx, sendMessageMethod, req, resp); err != nil {
+	err := c.API(ctx, sendMessageMethod, req, resp)
+	if err != nil && c.parseFallback && req.ParseMode != "" && IsCantParseEntities(err) {
+		if c.log != nil {
+			attrs := []slog.Attr{
+				slog.Int64("chat_id", chatID),
+				slog.String("parse_mode", string(req.ParseMode)),
+				slog.String("error", err.Error()),
+			}
+
+			if id := RequestIDFromContext(ctx); id != "" {
+				attrs = append(attrs, slog.String("request_id", id))
+			}
+
+			c.log.LogAttrs(ctx, slog.LevelWarn, "Send message as plain text", attrs...)
+		}
+
+		req.ParseMode = ""
+
+		// the escapes of SafeTextSendOption would be shown in the plain text
+		if req.flags.escape {
+			req.Text = text
+
+			if req.flags.truncate {
+				req.Text = TruncateText(text, MaxTextSize)
+			}
+		}
+
+		if err := c.waitChat(ctx, chatID); err != nil {
+			return nil, fmt.Errorf("SendMessage: %w", err)
+		}
+
+		err = c.API(ctx, sendMessageMethod, req, resp)
+	}
+
+	if err != nil {
 		return nil, fmt.Errorf("SendMessage: %w", err)
 	}
 
+	if c.dedup != nil {
+		c.dedup.put(key, resp)
+	}
+
 	return resp, nil
 }
 
@@ -457,14 +2039,27 @@ const editMessageTextMethod = "editMessageText"
 func (c *Client) EditMessage(ctx context.Context,
 	chatID, messageID int64, text string, opts ...EditOption,
 ) (*Message, error) {
-	req, err := NewEditMessage(chatID, messageID, text, opts...)
-	if err != nil {
+	req := newEditMessage(chatID, messageID, text, opts...)
+
+	if err := c.validateRequest(req); err != nil {
+		return nil, fmt.Errorf("EditMessage: %w", err)
+	}
+
+	if err := c.allowChat(chatID); err != nil {
+		return nil, fmt.Errorf("EditMessage: %w", err)
+	}
+
+	if err := c.waitChat(ctx, chatID); err != nil {
 		return nil, fmt.Errorf("EditMessage: %w", err)
 	}
 
 	resp := new(Message)
 
 	if err := c.API(ctx, editMessageTextMethod, req, resp); err != nil {
+		if c.ignoreNotModified && IsMessageNotModified(err) {
+			return &Message{MessageID: messageID, Chat: Chat{ID: chatID}, Text: text}, nil
+		}
+
 		return nil, fmt.Errorf("EditMessage: %w", err)
 	}
 
@@ -473,17 +2068,43 @@ func (c *Client) EditMessage(ctx context.Context,
 
 const deleteMessageMethod = "deleteMessage"
 
-func (c *Client) DeleteMessage(ctx context.Context, chatID, messageID int64) (bool, error) {
-	req, err := NewDeleteMessage(chatID, messageID)
-	if err != nil {
+func (c *Client) DeleteMessage(ctx context.Context, chatID, messageID int64, opts ...DeleteOption) (bool, error) {
+	req := newDeleteMessage(chatID, messageID, opts...)
+
+	if err := c.validateRequest(req); err != nil {
 		return false, fmt.Errorf("DeleteMessage: %w", err)
 	}
 
-	resp := false
+	if err := c.allowChat(chatID); err != nil {
+		return false, fmt.Errorf("DeleteMessage: %w", err)
+	}
 
-	if err := c.API(ctx, deleteMessageMethod, req, &resp); err != nil {
+	resp, err := c.callBool(ctx, deleteMessageMethod, req)
+	if err != nil {
 		return false, fmt.Errorf("DeleteMessage: %w", err)
 	}
 
 	return resp, nil
 }
+
+const sendChatActionMethod = "sendChatAction"
+
+func (c *Client) SendChatAction(ctx context.Context,
+	chatID int64, action ChatAction, opts ...ChatActionOption,
+) (bool, error) {
+	req, err := NewSendChatAction(chatID, action, opts...)
+	if err != nil {
+		return false, fmt.Errorf("SendChatAction: %w", err)
+	}
+
+	if err := c.allowChat(chatID); err != nil {
+		return false, fmt.Errorf("SendChatAction: %w", err)
+	}
+
+	resp, err := c.callBool(ctx, sendChatActionMethod, req)
+	if err != nil {
+		return false, fmt.Errorf("SendChatAction: %w", err)
+	}
+
+	return resp, nil
+}
@@ -3,16 +3,22 @@ package tg
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"math/rand"
+	"net"
 	"net/http"
 	"net/url"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -57,6 +63,89 @@ func Test_ParseMode_Validate(t *testing.T) {
 	}
 }
 
+func Test_ChatAction_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		action ChatAction
+		result error
+	}{
+		{
+			desc:   ErrUnknownChatAction.Error(),
+			action: ChatAction("test"),
+			result: ErrUnknownChatAction,
+		},
+		{
+			desc:   "empty",
+			action: ChatAction(""),
+			result: ErrUnknownChatAction,
+		},
+		{
+			desc:   "nil_result",
+			action: TypingChatAction,
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.action.Validate(), test.result)
+		})
+	}
+}
+
+func Test_SendChatAction_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		msg    func() *SendChatAction
+		result error
+	}{
+		{
+			desc:   ErrEmptyChatID.Error(),
+			msg:    func() *SendChatAction { return &SendChatAction{} },
+			result: ErrEmptyChatID,
+		},
+		{
+			desc: ErrIncorrectMessageThreadID.Error(),
+			msg: func() *SendChatAction {
+				return &SendChatAction{
+					ChatID:          1,
+					MessageThreadID: -1,
+				}
+			},
+			result: ErrIncorrectMessageThreadID,
+		},
+		{
+			desc:   ErrUnknownChatAction.Error(),
+			msg:    func() *SendChatAction { return &SendChatAction{ChatID: 1} },
+			result: ErrUnknownChatAction,
+		},
+		{
+			desc: "nil_result",
+			msg: func() *SendChatAction {
+				return &SendChatAction{
+					ChatID: 1,
+					Action: UploadDocumentChatAction,
+				}
+			},
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.msg().Validate(), test.result)
+		})
+	}
+}
+
 func Test_BaseMessage_Validate(t *testing.T) {
 	t.Parallel()
 
@@ -100,6 +189,53 @@ func Test_BaseMessage_Validate(t *testing.T) {
 			},
 			result: ErrUnknownParseMode,
 		},
+		{
+			desc: ErrIncorrectBusinessConnectionID.Error(),
+			msg: func() *BaseMessage {
+				return &BaseMessage{
+					ChatID: 1,
+					Text:   testText,
+					BusinessConnection: BusinessConnection{
+						BusinessConnectionID: "test test",
+					},
+				}
+			},
+			result: ErrIncorrectBusinessConnectionID,
+		},
+		{
+			desc: ErrParseModeWithEntities.Error(),
+			msg: func() *BaseMessage {
+				return &BaseMessage{
+					ChatID:    1,
+					Text:      testText,
+					ParseMode: HTMLParseMode,
+					Entities:  []MessageEntity{{Type: "bold", Length: 1}},
+				}
+			},
+			result: ErrParseModeWithEntities,
+		},
+		{
+			desc: ErrIncorrectEntityRange.Error(),
+			msg: func() *BaseMessage {
+				return &BaseMessage{
+					ChatID:   1,
+					Text:     testText,
+					Entities: []MessageEntity{{Type: "bold", Length: 1}, {Type: "italic", Offset: -1}},
+				}
+			},
+			result: fmt.Errorf("entities[1]: %w", ErrIncorrectEntityRange),
+		},
+		{
+			desc: "entities",
+			msg: func() *BaseMessage {
+				return &BaseMessage{
+					ChatID:   1,
+					Text:     testText,
+					Entities: []MessageEntity{{Type: "bold", Length: 1}},
+				}
+			},
+			result: nil,
+		},
 		{
 			desc: "nil_result",
 			msg: func() *BaseMessage {
@@ -122,6 +258,57 @@ func Test_BaseMessage_Validate(t *testing.T) {
 	}
 }
 
+func Test_ReplyParameters_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		params func() *ReplyParameters
+		result error
+	}{
+		{
+			desc:   ErrIncorrectMessageID.Error(),
+			params: func() *ReplyParameters { return &ReplyParameters{} },
+			result: ErrIncorrectMessageID,
+		},
+		{
+			desc:   ErrQuoteWithoutMessageID.Error(),
+			params: func() *ReplyParameters { return &ReplyParameters{Quote: "test"} },
+			result: ErrQuoteWithoutMessageID,
+		},
+		{
+			desc: ErrIncorrectQuotePosition.Error(),
+			params: func() *ReplyParameters {
+				return &ReplyParameters{
+					MessageID:     1,
+					Quote:         "test",
+					QuotePosition: -1,
+				}
+			},
+			result: ErrIncorrectQuotePosition,
+		},
+		{
+			desc: "nil_result",
+			params: func() *ReplyParameters {
+				return &ReplyParameters{
+					MessageID: 1,
+					ChatID:    -1,
+					Quote:     "test",
+				}
+			},
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.params().Validate(), test.result)
+		})
+	}
+}
+
 func Test_SendMessage_Validate(t *testing.T) {
 	t.Parallel()
 
@@ -149,6 +336,47 @@ func Test_SendMessage_Validate(t *testing.T) {
 			msg:    func() *SendMessage { return &SendMessage{} },
 			result: ErrEmptyChatID,
 		},
+		{
+			desc: ErrQuoteWithoutMessageID.Error(),
+			msg: func() *SendMessage {
+				return &SendMessage{
+					BaseMessage: BaseMessage{
+						ChatID: 1,
+						Text:   testText,
+					},
+					ReplyParameters: &ReplyParameters{
+						Quote: "test",
+					},
+				}
+			},
+			result: ErrQuoteWithoutMessageID,
+		},
+		{
+			desc: ErrIncorrectScheduleDate.Error(),
+			msg: func() *SendMessage {
+				return &SendMessage{
+					BaseMessage: BaseMessage{
+						ChatID: 1,
+						Text:   testText,
+					},
+					ScheduleDate: time.Now().Add(-time.Minute).Unix(),
+				}
+			},
+			result: ErrIncorrectScheduleDate,
+		},
+		{
+			desc: ErrIncorrectMessageEffectID.Error(),
+			msg: func() *SendMessage {
+				return &SendMessage{
+					BaseMessage: BaseMessage{
+						ChatID: 1,
+						Text:   testText,
+					},
+					MessageEffectID: " ",
+				}
+			},
+			result: ErrIncorrectMessageEffectID,
+		},
 		{
 			desc: "nil_result",
 			msg: func() *SendMessage {
@@ -159,6 +387,7 @@ func Test_SendMessage_Validate(t *testing.T) {
 						ParseMode: MarkdownV2ParseMode,
 					},
 					MessageThreadID: 0,
+					ScheduleDate:    time.Now().Add(time.Hour).Unix(),
 				}
 			},
 			result: nil,
@@ -248,6 +477,19 @@ func Test_DeleteMessage_Validate(t *testing.T) {
 			},
 			result: ErrIncorrectMessageID,
 		},
+		{
+			desc: ErrIncorrectBusinessConnectionID.Error(),
+			msg: func() *DeleteMessage {
+				return &DeleteMessage{
+					ChatID:    1,
+					MessageID: 1,
+					BusinessConnection: BusinessConnection{
+						BusinessConnectionID: " ",
+					},
+				}
+			},
+			result: ErrIncorrectBusinessConnectionID,
+		},
 		{
 			desc: "nil_result",
 			msg: func() *DeleteMessage {
@@ -370,6 +612,56 @@ func Test_NewClient(t *testing.T) {
 			},
 			result: ErrHTTPClientNil,
 		},
+		{
+			desc:  ErrLoggerNil.Error(),
+			token: testToken,
+			options: func() []Option {
+				return []Option{
+					WithLogger(nil),
+				}
+			},
+			result: ErrLoggerNil,
+		},
+		{
+			desc:  ErrIncorrectMaxResponseSize.Error(),
+			token: testToken,
+			options: func() []Option {
+				return []Option{
+					WithMaxResponseSize(0),
+				}
+			},
+			result: ErrIncorrectMaxResponseSize,
+		},
+		{
+			desc:  ErrIncorrectDedupWindow.Error(),
+			token: testToken,
+			options: func() []Option {
+				return []Option{
+					WithDedup(0),
+				}
+			},
+			result: ErrIncorrectDedupWindow,
+		},
+		{
+			desc:  ErrTransportNil.Error(),
+			token: testToken,
+			options: func() []Option {
+				return []Option{
+					WithTransport(nil),
+				}
+			},
+			result: ErrTransportNil,
+		},
+		{
+			desc:  ErrIncorrectRateLimit.Error(),
+			token: testToken,
+			options: func() []Option {
+				return []Option{
+					WithPerChatRateLimit(1, 0),
+				}
+			},
+			result: ErrIncorrectRateLimit,
+		},
 		{
 			desc:  "err_return_options",
 			token: testToken,
@@ -512,6 +804,72 @@ func Test_Client_API(t *testing.T) {
 			},
 			result: fmt.Errorf("response: %w", ResponseError{}),
 		},
+		{
+			desc: "bad_gateway_html",
+			http: func() HTTPClient {
+				client := &mockHTTPClient{}
+				client.On("Do", mock.Anything, mock.Anything).
+					Return(
+						&http.Response{
+							StatusCode: http.StatusBadGateway,
+							Body:       io.NopCloser(bytes.NewBufferString("<html>502 Bad Gateway</html>")),
+						},
+						nil,
+					)
+
+				return client
+			},
+			req: func() any { return nil },
+			resp: func() any {
+				return reflect.New(reflect.TypeOf(struct{}{})).Interface()
+			},
+			result: fmt.Errorf("response: %w: %w", ErrUnexpectedStatus,
+				&statusError{code: http.StatusBadGateway, body: "<html>502 Bad Gateway</html>"}),
+		},
+		{
+			desc: "internal_server_error_empty",
+			http: func() HTTPClient {
+				client := &mockHTTPClient{}
+				client.On("Do", mock.Anything, mock.Anything).
+					Return(
+						&http.Response{
+							StatusCode: http.StatusInternalServerError,
+							Body:       io.NopCloser(bytes.NewBuffer([]byte{})),
+						},
+						nil,
+					)
+
+				return client
+			},
+			req: func() any { return nil },
+			resp: func() any {
+				return reflect.New(reflect.TypeOf(struct{}{})).Interface()
+			},
+			result: fmt.Errorf("response: %w: %w", ErrUnexpectedStatus,
+				&statusError{code: http.StatusInternalServerError, body: ""}),
+		},
+		{
+			desc: "bad_gateway_json",
+			http: func() HTTPClient {
+				client := &mockHTTPClient{}
+				client.On("Do", mock.Anything, mock.Anything).
+					Return(
+						&http.Response{
+							StatusCode: http.StatusBadGateway,
+							Body:       io.NopCloser(bytes.NewBufferString(`{"message":"bad gateway"}`)),
+						},
+						nil,
+					)
+
+				return client
+			},
+			req: func() any { return nil },
+			resp: func() any {
+				return reflect.New(reflect.TypeOf(struct{}{})).Interface()
+			},
+			result: fmt.Errorf("response: %w: %w", ErrUnexpectedStatus,
+				&statusError{code: http.StatusBadGateway, body: `{"message":"bad gateway"}`}),
+		},
 		{
 			desc: "nil_err",
 			http: func() HTTPClient {
@@ -549,3 +907,1189 @@ func Test_Client_API(t *testing.T) {
 		})
 	}
 }
+
+func Test_Client_API_RequestID(t *testing.T) {
+	t.Parallel()
+
+	resp := new(Response)
+	resp.Ok = true
+
+	body, _ := json.Marshal(resp) //nolint:errchkjson
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.Anything).Return(
+		&http.Response{
+			Body: io.NopCloser(bytes.NewBuffer(body)),
+		},
+		nil,
+	)
+
+	out := new(bytes.Buffer)
+
+	client := new(Client)
+	client.http = httpClient
+	client.log = slog.New(slog.NewJSONHandler(out, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	ctx := WithRequestID(context.Background(), "test-id")
+
+	assert.Equal(t, "test-id", RequestIDFromContext(ctx))
+	assert.NoError(t, client.API(ctx, getMeMethod, nil, new(User)))
+	assert.Contains(t, out.String(), `"method":"getMe"`)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+
+	assert.Len(t, lines, 2)
+	assert.Contains(t, lines[0], `"msg":"API request"`)
+
+	for _, line := range lines {
+		assert.Contains(t, line, `"request_id":"test-id"`)
+	}
+}
+
+func Test_Client_APIWithMeta(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.Anything).Return(func(*http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(bytes.NewBufferString(
+				`{"ok":true,"result":{"id":1},"parameters":{"migrate_to_chat_id":-1001234567890}}`,
+			)),
+		}, nil
+	}).Once()
+
+	client := new(Client)
+	client.http = httpClient
+
+	user := new(User)
+
+	resp, err := client.APIWithMeta(context.Background(), getMeMethod, nil, user)
+
+	assert.NoError(t, err)
+	assert.True(t, resp.Ok)
+	assert.Equal(t, int64(-1001234567890), resp.Parameters.MigrateToChatID)
+	assert.Equal(t, user, resp.Result)
+	assert.Equal(t, int64(1), user.ID)
+}
+
+func Test_Client_marshal(t *testing.T) {
+	t.Parallel()
+
+	msg, err := NewSendMessage(1, "test")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		desc     string
+		explicit bool
+		result   string
+	}{
+		{
+			desc:     "omit_defaults",
+			explicit: false,
+			result:   `{"chat_id":1,"text":"test"}`,
+		},
+		{
+			desc:     "explicit_defaults",
+			explicit: true,
+			result: `{"chat_id":1,"disable_notification":false,"disable_web_page_preview":false,` +
+				`"message_thread_id":0,"parse_mode":"","protect_content":false,"text":"test"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			client := new(Client)
+			client.explicitDefaults = test.explicit
+
+			body, err := client.marshal(msg)
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.result, string(body))
+		})
+	}
+}
+
+func Test_Client_marshal_Entities(t *testing.T) {
+	t.Parallel()
+
+	client := new(Client)
+
+	body, err := client.marshal(&SendMessage{
+		BaseMessage: BaseMessage{
+			ChatID:    1,
+			Text:      "<b>",
+			ParseMode: HTMLParseMode,
+			Entities:  []MessageEntity{{Type: "bold", Length: 3}},
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, `{"chat_id":1,"text":"<b>","entities":[{"type":"bold","offset":0,"length":3}]}`, string(body))
+}
+
+func Test_Client_SendMessage_Entities(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		body, _ := io.ReadAll(req.Body)
+
+		return string(body) == `{"chat_id":1,"text":"test","entities":[{"type":"bold","offset":0,"length":4}]}`
+	})).Return(
+		&http.Response{
+			Body: io.NopCloser(bytes.NewBufferString(`{"ok":true,"result":{"message_id":1}}`)),
+		},
+		nil,
+	).Once()
+
+	client := new(Client)
+	client.http = httpClient
+
+	assert.NoError(t, WithDefaultParseMode(HTMLParseMode)(client))
+
+	_, err := client.SendMessage(context.Background(), 1, "test",
+		EntitiesSendOption(MessageEntity{Type: "bold", Length: 4}),
+	)
+	assert.NoError(t, err)
+
+	_, err = client.SendMessage(context.Background(), 1, "test",
+		ParseModeSendOption(HTMLParseMode),
+		EntitiesSendOption(MessageEntity{Type: "bold", Length: 4}),
+	)
+	assert.ErrorIs(t, err, ErrParseModeWithEntities)
+}
+
+func Test_Client_API_MaxResponseSize(t *testing.T) {
+	t.Parallel()
+
+	body := `{"ok":true,"result":{"id":1,"first_name":"` + strings.Repeat("a", 64) + `"}}`
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.Anything).Return(
+		&http.Response{
+			Body: io.NopCloser(bytes.NewBufferString(body)),
+		},
+		nil,
+	)
+
+	client := new(Client)
+	client.http = httpClient
+	client.maxResponseSize = 32
+
+	assert.Equal(t,
+		client.API(context.Background(), getMeMethod, nil, new(User)),
+		fmt.Errorf("response: %w", ErrResponseTooLarge),
+	)
+}
+
+func Test_NewClient_APIServer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		server string
+		result string
+	}{
+		{
+			desc:   "host",
+			server: "http://test",
+			result: "http://test/bot1:test/",
+		},
+		{
+			desc:   "host_slash",
+			server: "http://test/",
+			result: "http://test/bot1:test/",
+		},
+		{
+			desc:   "path",
+			server: "https://test/telegram",
+			result: "https://test/telegram/bot1:test/",
+		},
+		{
+			desc:   "path_slash",
+			server: "https://test:8443/telegram/api/",
+			result: "https://test:8443/telegram/api/bot1:test/",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			client, err := NewClient(testToken, WithAPIServer(test.server))
+			assert.NoError(t, err)
+
+			url, err := client.botURL(context.Background(), client.endpoint, "")
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.result, url)
+		})
+	}
+}
+
+func Test_Client_Close(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc    string
+		options []Option
+	}{
+		{
+			desc:    "default",
+			options: []Option{},
+		},
+		{
+			desc:    "transport",
+			options: []Option{WithTransport(&http.Transport{})},
+		},
+		{
+			desc:    "http_client",
+			options: []Option{WithHTTPClient(&mockHTTPClient{})},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			client, err := NewClient(testToken, test.options...)
+
+			assert.NoError(t, err)
+			assert.NotPanics(t, func() { assert.NoError(t, client.Close()) })
+		})
+	}
+}
+
+func Test_NewClient_OwnHTTPClient(t *testing.T) {
+	t.Parallel()
+
+	client1, err := NewClient(testToken)
+	assert.NoError(t, err)
+
+	client2, err := NewClient(testToken)
+	assert.NoError(t, err)
+
+	assert.NotSame(t, defaultHTTPClient, client1.http)
+	assert.NotSame(t, client1.http, client2.http)
+	assert.NotSame(t, defaultHTTPClient.Transport, client1.http.(*http.Client).Transport)
+	assert.NotSame(t, client1.http.(*http.Client).Transport, client2.http.(*http.Client).Transport)
+}
+
+func Test_Client_SendMessage_ExperimentalFields(t *testing.T) {
+	t.Parallel()
+
+	client := new(Client)
+
+	_, err := client.SendMessage(context.Background(), 1, "test",
+		ScheduleDateSendOption(time.Now().Add(time.Hour)),
+	)
+
+	assert.Equal(t, fmt.Errorf("SendMessage: schedule_date: %w", ErrExperimentalFields), err)
+}
+
+func Test_BusinessConnectionOption(t *testing.T) {
+	t.Parallel()
+
+	opt := BusinessConnectionOption("test")
+
+	sm, err := NewSendMessage(1, "test", opt.Send())
+	assert.NoError(t, err)
+
+	em, err := NewEditMessage(1, 1, "test", opt.Edit())
+	assert.NoError(t, err)
+
+	dm, err := NewDeleteMessage(1, 1, opt.Delete())
+	assert.NoError(t, err)
+
+	el, err := NewEditMessageLiveLocation(1, 1, 1, 1, opt.EditLiveLocation())
+	assert.NoError(t, err)
+
+	sl, err := NewStopMessageLiveLocation(1, 1, opt.StopLiveLocation())
+	assert.NoError(t, err)
+
+	for _, req := range []any{sm, em, dm, el, sl} {
+		body, err := json.Marshal(req)
+
+		assert.NoError(t, err)
+		assert.Contains(t, string(body), `"business_connection_id":"test"`)
+	}
+}
+
+func Test_Client_API_ContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.Anything).Return(func(req *http.Request) (*http.Response, error) {
+		<-req.Context().Done()
+
+		return nil, req.Context().Err()
+	})
+
+	client := new(Client)
+	client.http = httpClient
+
+	go cancel()
+
+	err := client.API(ctx, getMeMethod, nil, new(User))
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, fmt.Errorf("request: %w", context.Canceled), err)
+}
+
+func Test_Client_API_PreferGET(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc      string
+		preferGET bool
+		result    string
+	}{
+		{
+			desc:      "post",
+			preferGET: false,
+			result:    http.MethodPost,
+		},
+		{
+			desc:      "get",
+			preferGET: true,
+			result:    http.MethodGet,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			httpClient := newMockHTTPClient(t)
+			httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+				return req.Method == test.result
+			})).Return(
+				&http.Response{
+					Body: io.NopCloser(bytes.NewBufferString(`{"ok":true,"result":{"id":1}}`)),
+				},
+				nil,
+			)
+
+			client := new(Client)
+			client.http = httpClient
+			client.preferGET = test.preferGET
+
+			_, err := client.GetMe(context.Background())
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_Client_API_PreferGET_Body(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Method == http.MethodPost
+	})).Return(
+		&http.Response{
+			Body: io.NopCloser(bytes.NewBufferString(`{"ok":true,"result":true}`)),
+		},
+		nil,
+	)
+
+	client := new(Client)
+	client.http = httpClient
+	client.preferGET = true
+
+	_, err := client.DeleteMessage(context.Background(), 1, 1)
+
+	assert.NoError(t, err)
+}
+
+func Test_ReplyToMessageIDSendOption(t *testing.T) {
+	t.Parallel()
+
+	sm, err := NewSendMessage(1, "test",
+		ReplyParametersSendOption(ReplyParameters{Quote: "test"}),
+		ReplyToMessageIDSendOption(2),
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &ReplyParameters{MessageID: 2, Quote: "test"}, sm.ReplyParameters)
+}
+
+func Test_ValidateAll(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		msg    interface{ ValidateAll() error }
+		result []error
+	}{
+		{
+			desc: "base_message",
+			msg: &BaseMessage{
+				Text:      testBadText,
+				ParseMode: testBadParseMode,
+			},
+			result: []error{ErrEmptyChatID, ErrTextTooLong, ErrUnknownParseMode},
+		},
+		{
+			desc: "send_message",
+			msg: &SendMessage{
+				MessageThreadID: -1,
+				ReplyParameters: &ReplyParameters{Quote: "test"},
+			},
+			result: []error{ErrEmptyChatID, ErrEmptyText, ErrIncorrectMessageThreadID, ErrQuoteWithoutMessageID},
+		},
+		{
+			desc:   "edit_message",
+			msg:    &EditMessage{},
+			result: []error{ErrIncorrectMessageID, ErrEmptyChatID, ErrEmptyText},
+		},
+		{
+			desc:   "delete_message",
+			msg:    &DeleteMessage{},
+			result: []error{ErrEmptyChatID, ErrIncorrectMessageID},
+		},
+		{
+			desc: "nil_result",
+			msg: &DeleteMessage{
+				ChatID:    1,
+				MessageID: 1,
+			},
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.msg.ValidateAll()
+
+			if test.result == nil {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.Equal(t, errors.Join(test.result...), err)
+		})
+	}
+}
+
+func Test_Client_SendMessage_SkipValidation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc           string
+		skipValidation bool
+		result         error
+	}{
+		{
+			desc:           "strict",
+			skipValidation: false,
+			result:         fmt.Errorf("SendMessage: %w", ErrUnknownParseMode),
+		},
+		{
+			desc:           "skip",
+			skipValidation: true,
+			result:         nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			httpClient := &mockHTTPClient{}
+			httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+				body, _ := io.ReadAll(req.Body)
+
+				return strings.Contains(string(body), `"parse_mode":"Custom"`)
+			})).Return(
+				&http.Response{
+					Body: io.NopCloser(bytes.NewBufferString(`{"ok":true,"result":{"message_id":1}}`)),
+				},
+				nil,
+			)
+
+			client := new(Client)
+			client.http = httpClient
+
+			assert.NoError(t, WithSkipValidation(test.skipValidation)(client))
+
+			_, err := client.SendMessage(context.Background(), 1, "test", ParseModeSendOption("Custom"))
+
+			assert.Equal(t, test.result, err)
+
+			if test.skipValidation {
+				httpClient.AssertNumberOfCalls(t, "Do", 1)
+			} else {
+				httpClient.AssertNotCalled(t, "Do", mock.Anything)
+			}
+		})
+	}
+}
+
+func Test_Message_Time(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		date   int
+		result time.Time
+	}{
+		{
+			desc:   "epoch",
+			date:   0,
+			result: time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			desc:   "timestamp",
+			date:   1700000000,
+			result: time.Date(2023, time.November, 14, 22, 13, 20, 0, time.UTC),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.result, (&Message{Date: test.date}).Time())
+		})
+	}
+}
+
+func Test_Client_SendMessage_DefaultParseMode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		opts   []SendOption
+		result string
+	}{
+		{
+			desc:   "default",
+			opts:   nil,
+			result: `"parse_mode":"HTML"`,
+		},
+		{
+			desc:   "override",
+			opts:   []SendOption{ParseModeSendOption(MarkdownV2ParseMode)},
+			result: `"parse_mode":"MarkdownV2"`,
+		},
+		{
+			desc:   "plain_text",
+			opts:   []SendOption{PlainTextSendOption()},
+			result: `"text":"test"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			httpClient := newMockHTTPClient(t)
+			httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+				body, _ := io.ReadAll(req.Body)
+
+				return strings.Contains(string(body), test.result)
+			})).Return(
+				&http.Response{
+					Body: io.NopCloser(bytes.NewBufferString(`{"ok":true,"result":{"message_id":1}}`)),
+				},
+				nil,
+			)
+
+			client := new(Client)
+			client.http = httpClient
+
+			assert.NoError(t, WithDefaultParseMode(HTMLParseMode)(client))
+
+			_, err := client.SendMessage(context.Background(), 1, "test", test.opts...)
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_Client_marshal_EscapeHTML(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc       string
+		escapeHTML bool
+		result     string
+	}{
+		{
+			desc:       "literal",
+			escapeHTML: false,
+			result:     `{"chat_id":1,"text":"<b>a & b</b>","parse_mode":"HTML"}`,
+		},
+		{
+			desc:       "escaped",
+			escapeHTML: true,
+			result:     `{"chat_id":1,"text":"\u003cb\u003ea \u0026 b\u003c/b\u003e","parse_mode":"HTML"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			httpClient := newMockHTTPClient(t)
+			httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+				body, _ := io.ReadAll(req.Body)
+
+				return string(body) == test.result
+			})).Return(
+				&http.Response{
+					Body: io.NopCloser(bytes.NewBufferString(`{"ok":true,"result":{"message_id":1}}`)),
+				},
+				nil,
+			)
+
+			client := new(Client)
+			client.http = httpClient
+
+			assert.NoError(t, WithEscapeHTML(test.escapeHTML)(client))
+
+			_, err := client.SendMessage(context.Background(), 1, "<b>a & b</b>", ParseModeSendOption(HTMLParseMode))
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_Client_SendMessage_ParseFallback(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc     string
+		fallback bool
+		option   SendOption
+		bodies   []string
+		result   error
+	}{
+		{
+			desc:     "fallback",
+			fallback: true,
+			option:   ParseModeSendOption(MarkdownParseMode),
+			bodies: []string{
+				`{"chat_id":1,"text":"a_b","parse_mode":"Markdown"}`,
+				`{"chat_id":1,"text":"a_b"}`,
+			},
+			result: nil,
+		},
+		{
+			desc:     "safe_text",
+			fallback: true,
+			option:   SafeTextSendOption(MarkdownV2ParseMode),
+			bodies: []string{
+				`{"chat_id":1,"text":"a\\_b","parse_mode":"MarkdownV2"}`,
+				`{"chat_id":1,"text":"a_b"}`,
+			},
+			result: nil,
+		},
+		{
+			desc:     ErrCantParseEntities.Error(),
+			fallback: false,
+			option:   ParseModeSendOption(MarkdownParseMode),
+			bodies:   []string{`{"chat_id":1,"text":"a_b","parse_mode":"Markdown"}`},
+			result:   ErrCantParseEntities,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			var bodies []string
+
+			httpClient := newMockHTTPClient(t)
+			httpClient.On("Do", mock.Anything).Return(func(req *http.Request) (*http.Response, error) {
+				body, _ := io.ReadAll(req.Body)
+				bodies = append(bodies, string(body))
+
+				resp := `{"ok":true,"result":{"message_id":1,"text":"a_b"}}`
+				if strings.Contains(string(body), "parse_mode") {
+					resp = `{"ok":false,"error_code":400,"description":"Bad Request: can't parse entities: ` +
+						`Can't find end of the entity starting at byte offset 1"}`
+				}
+
+				return &http.Response{Body: io.NopCloser(bytes.NewBufferString(resp))}, nil
+			})
+
+			logs := new(bytes.Buffer)
+
+			now := time.Now()
+
+			client := new(Client)
+			client.http = httpClient
+			client.chatLimiter = newChatLimiter(1, 2)
+			client.chatLimiter.now = func() time.Time { return now }
+
+			assert.NoError(t, WithParseFallback(test.fallback)(client))
+			assert.NoError(t, WithLogger(slog.New(slog.NewTextHandler(logs, nil)))(client))
+
+			_, err := client.SendMessage(WithRequestID(context.Background(), "test-id"), 1, "a_b", test.option)
+
+			assert.ErrorIs(t, err, test.result)
+			assert.Equal(t, test.bodies, bodies)
+
+			bucket, _ := client.chatLimiter.buckets[1].Value.(*chatBucket)
+			assert.Equal(t, float64(2-len(test.bodies)), bucket.tokens)
+			assert.Equal(t, test.fallback, strings.Contains(logs.String(), "level=WARN"))
+
+			for _, line := range strings.Split(strings.TrimSpace(logs.String()), "\n") {
+				if strings.Contains(line, "level=WARN") {
+					assert.Contains(t, line, "request_id=test-id")
+				}
+			}
+		})
+	}
+}
+
+func Test_Client_EditMessage_IgnoreNotModified(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		ignore bool
+		result *Message
+	}{
+		{
+			desc:   "strict",
+			ignore: false,
+			result: nil,
+		},
+		{
+			desc:   "ignore",
+			ignore: true,
+			result: &Message{MessageID: 2, Chat: Chat{ID: 1}, Text: "test"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			httpClient := newMockHTTPClient(t)
+			httpClient.On("Do", mock.Anything).Return(func(*http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusBadRequest,
+					Body: io.NopCloser(bytes.NewBufferString(
+						`{"ok":false,"error_code":400,"description":"Bad Request: message is not modified"}`)),
+				}, nil
+			})
+
+			client := new(Client)
+			client.http = httpClient
+
+			assert.NoError(t, WithIgnoreNotModified(test.ignore)(client))
+
+			msg, err := client.EditMessage(context.Background(), 1, 2, "test")
+
+			assert.Equal(t, test.result, msg)
+			assert.Equal(t, !test.ignore, IsMessageNotModified(err))
+		})
+	}
+}
+
+func Test_Client_API_Compression(t *testing.T) {
+	t.Parallel()
+
+	compressed := new(bytes.Buffer)
+
+	gzipWriter := gzip.NewWriter(compressed)
+	_, _ = gzipWriter.Write([]byte(`{"ok":true,"result":{"id":1,"first_name":"test"}}`))
+	_ = gzipWriter.Close()
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Header.Get("Accept-Encoding") == "gzip"
+	})).Return(
+		&http.Response{
+			Header: http.Header{"Content-Encoding": []string{"gzip"}},
+			Body:   io.NopCloser(compressed),
+		},
+		nil,
+	)
+
+	client := new(Client)
+	client.http = httpClient
+
+	assert.NoError(t, WithCompression(true)(client))
+
+	user, err := client.GetMe(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "test", user.FirstName)
+}
+
+func Test_NewClientVerified(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		body   string
+		result *User
+	}{
+		{
+			desc:   "unauthorized",
+			body:   `{"ok":false,"error_code":401,"description":"Unauthorized"}`,
+			result: nil,
+		},
+		{
+			desc:   "verified",
+			body:   `{"ok":true,"result":{"id":1,"first_name":"test"}}`,
+			result: &User{ID: 1, FirstName: "test"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			httpClient := newMockHTTPClient(t)
+			httpClient.On("Do", mock.Anything).Return(func(*http.Request) (*http.Response, error) {
+				return &http.Response{Body: io.NopCloser(bytes.NewBufferString(test.body))}, nil
+			})
+
+			client, err := NewClientVerified(context.Background(), testToken, WithHTTPClient(httpClient))
+
+			if test.result == nil {
+				assert.ErrorIs(t, err, ErrUnauthorized)
+				assert.Nil(t, client)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.result, client.Me())
+		})
+	}
+}
+
+func Test_WithDialContext(t *testing.T) {
+	t.Parallel()
+
+	dialed := make(chan string, 1)
+
+	dial := func(_ context.Context, _, addr string) (net.Conn, error) {
+		dialed <- addr
+
+		return nil, errTest
+	}
+
+	_, err := NewClient(testToken, WithHTTPClient(newMockHTTPClient(t)), WithDialContext(dial))
+	assert.Equal(t, fmt.Errorf("Client: %w", ErrDialContextHTTPClient), err)
+
+	_, err = NewClient(testToken, WithDialContext(nil))
+	assert.Equal(t, fmt.Errorf("Client: %w", ErrDialContextNil), err)
+
+	client, err := NewClient(testToken, WithAPIServer("http://tg.test"), WithDialContext(dial))
+	assert.NoError(t, err)
+
+	_, err = client.GetMe(context.Background())
+
+	assert.ErrorIs(t, err, errTest)
+	assert.Equal(t, "tg.test:80", <-dialed)
+}
+
+func Test_WithInsecureSkipVerify(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewClient(testToken, WithHTTPClient(newMockHTTPClient(t)), WithInsecureSkipVerify(true))
+	assert.Equal(t, fmt.Errorf("Client: %w", ErrTLSConfigHTTPClient), err)
+
+	_, err = NewClient(testToken, WithTLSConfig(nil))
+	assert.Equal(t, fmt.Errorf("Client: %w", ErrTLSConfigNil), err)
+
+	client, err := NewClient(testToken, WithInsecureSkipVerify(false))
+	assert.NoError(t, err)
+	assert.NotSame(t, defaultHTTPClient, client.http)
+
+	client, err = NewClient(testToken, WithInsecureSkipVerify(true))
+	assert.NoError(t, err)
+
+	transport, _ := client.http.(*http.Client).Transport.(*http.Transport)
+	assert.True(t, transport.TLSClientConfig.InsecureSkipVerify)
+
+	config := &tls.Config{ServerName: "tg.test"}
+
+	client, err = NewClient(testToken, WithTLSConfig(config), WithInsecureSkipVerify(true))
+	assert.NoError(t, err)
+
+	transport, _ = client.http.(*http.Client).Transport.(*http.Transport)
+	assert.True(t, transport.TLSClientConfig.InsecureSkipVerify)
+	assert.Equal(t, "tg.test", transport.TLSClientConfig.ServerName)
+	assert.False(t, config.InsecureSkipVerify)
+}
+
+func Test_WithForceHTTP1_WithDisableKeepAlives(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewClient(testToken, WithHTTPClient(newMockHTTPClient(t)), WithForceHTTP1(true))
+	assert.Equal(t, fmt.Errorf("Client: %w", ErrConnectionHTTPClient), err)
+
+	_, err = NewClient(testToken, WithHTTPClient(newMockHTTPClient(t)), WithDisableKeepAlives(true))
+	assert.Equal(t, fmt.Errorf("Client: %w", ErrConnectionHTTPClient), err)
+
+	client, err := NewClient(testToken, WithForceHTTP1(false), WithDisableKeepAlives(false))
+	assert.NoError(t, err)
+	assert.NotSame(t, defaultHTTPClient, client.http)
+
+	client, err = NewClient(testToken,
+		WithTransport(&http.Transport{ForceAttemptHTTP2: true}),
+		WithForceHTTP1(true),
+		WithDisableKeepAlives(true),
+	)
+	assert.NoError(t, err)
+
+	transport, _ := client.http.(*http.Client).Transport.(*http.Transport)
+	assert.False(t, transport.ForceAttemptHTTP2)
+	assert.NotNil(t, transport.TLSNextProto)
+	assert.Empty(t, transport.TLSNextProto)
+	assert.True(t, transport.DisableKeepAlives)
+}
+
+func Test_Client_API_DebugLog(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.Anything).Return(func(*http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewBufferString(`{"ok":true,"result":true}`)),
+		}, nil
+	})
+
+	buf := new(bytes.Buffer)
+	log := slog.New(slog.NewTextHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client, err := NewClient(testToken, WithHTTPClient(httpClient), WithLogger(log))
+	assert.NoError(t, err)
+
+	_, err = client.DeleteMessage(context.Background(), 1, 2)
+	assert.NoError(t, err)
+
+	assert.Contains(t, buf.String(), `url=https://api.telegram.org/bot<token>/deleteMessage`)
+	assert.Contains(t, buf.String(), `payload="{\"chat_id\":1,\"message_id\":2}"`)
+	assert.Contains(t, buf.String(), `status=200`)
+	assert.NotContains(t, buf.String(), testToken)
+}
+
+func Test_Client_API_RedactToken(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.Anything).Return(func(req *http.Request) (*http.Response, error) {
+		return nil, &url.Error{Op: "Post", URL: req.URL.String(), Err: errTest}
+	})
+
+	client, err := NewClient(testToken, WithHTTPClient(httpClient))
+	assert.NoError(t, err)
+
+	_, err = client.GetMe(context.Background())
+
+	assert.ErrorIs(t, err, errTest)
+	assert.NotContains(t, err.Error(), testToken)
+	assert.Equal(t, `GetMe: request: Post "https://api.telegram.org/bot<token>/getMe": test`, err.Error())
+}
+
+func Test_redactToken(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "http://localhost:8081/api/bot<token>/sendMessage",
+		redactToken("http://localhost:8081/api/bot123:ABC-def_1/sendMessage"))
+	assert.Equal(t, "https://api.telegram.org/", redactToken("https://api.telegram.org/"))
+}
+
+func Test_MessageThreadID_Validate(t *testing.T) {
+	t.Parallel()
+
+	requests := map[string]func(threadID int64) interface{ Validate() error }{
+		"send_message": func(threadID int64) interface{ Validate() error } {
+			return newSendMessage(1, testText, MessageThreadIDSendOption(threadID))
+		},
+		"send_chat_action": func(threadID int64) interface{ Validate() error } {
+			return &SendChatAction{
+				ChatID:          1,
+				MessageThreadID: threadID,
+				Action:          TypingChatAction,
+			}
+		},
+		"send_video": func(threadID int64) interface{ Validate() error } {
+			sv := &SendVideoMessage{ChatID: 1, Video: FileFromID("test")}
+			MessageThreadIDMediaOption(threadID).applySendVideo(sv)
+
+			return sv
+		},
+		"send_location": func(threadID int64) interface{ Validate() error } {
+			sl := &SendLocationMessage{ChatID: 1}
+			MessageThreadIDSendLocationOption(threadID)(sl)
+
+			return sl
+		},
+		"send_venue": func(threadID int64) interface{ Validate() error } {
+			sv := &SendVenueMessage{ChatID: 1, Title: "test", Address: "test"}
+			MessageThreadIDSendVenueOption(threadID)(sv)
+
+			return sv
+		},
+	}
+
+	tests := []struct {
+		desc     string
+		threadID int64
+		result   error
+	}{
+		{
+			desc:     ErrIncorrectMessageThreadID.Error(),
+			threadID: -1,
+			result:   ErrIncorrectMessageThreadID,
+		},
+		{
+			desc:     "nil_result_general",
+			threadID: 0,
+			result:   nil,
+		},
+		{
+			desc:     "nil_result",
+			threadID: 1,
+			result:   nil,
+		},
+	}
+
+	for name, request := range requests {
+		for _, test := range tests {
+			t.Run(name+"_"+test.desc, func(t *testing.T) {
+				t.Parallel()
+
+				assert.Equal(t, test.result, request(test.threadID).Validate())
+			})
+		}
+	}
+}
+
+func Test_Client_DeleteMessage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		body   string
+		result bool
+		err    bool
+	}{
+		{
+			desc:   "true",
+			body:   `{"ok":true,"result":true}`,
+			result: true,
+		},
+		{
+			desc:   "false",
+			body:   `{"ok":true,"result":false}`,
+			result: false,
+		},
+		{
+			desc: "object_result",
+			body: `{"ok":true,"result":{"message_id":1}}`,
+			err:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			httpClient := newMockHTTPClient(t)
+			httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+				return req.URL.String() == deleteMessageMethod
+			})).Return(
+				&http.Response{
+					Body: io.NopCloser(bytes.NewBufferString(test.body)),
+				},
+				nil,
+			)
+
+			client := new(Client)
+			client.http = httpClient
+
+			result, err := client.DeleteMessage(context.Background(), 1, 1)
+
+			assert.Equal(t, test.err, err != nil)
+			assert.Equal(t, test.result, result)
+		})
+	}
+}
+
+func Test_Client_DeleteMessage_BusinessConnection(t *testing.T) {
+	t.Parallel()
+
+	var body string
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.Anything).Return(func(req *http.Request) (*http.Response, error) {
+		raw, _ := io.ReadAll(req.Body)
+		body = string(raw)
+
+		return &http.Response{Body: io.NopCloser(bytes.NewBufferString(`{"ok":true,"result":true}`))}, nil
+	})
+
+	client := new(Client)
+	client.http = httpClient
+
+	result, err := client.DeleteMessage(context.Background(), 1, 1, BusinessConnectionOption("test").Delete())
+
+	assert.NoError(t, err)
+	assert.True(t, result)
+	assert.Equal(t, `{"chat_id":1,"message_id":1,"business_connection_id":"test"}`, body)
+}
+
+func Test_Client_MethodEndpoint(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.String() == "https://media.example.com/gw/bot"+testToken+"/"+sendVideoMethod
+	})).Return(func(*http.Request) (*http.Response, error) {
+		return &http.Response{
+			Body: io.NopCloser(bytes.NewBufferString(`{"ok":true,"result":{"message_id":1}}`)),
+		}, nil
+	}).Once()
+	httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		return req.URL.String() == "https://api.example.com/bot"+testToken+"/"+sendMessageMethod
+	})).Return(func(*http.Request) (*http.Response, error) {
+		return &http.Response{
+			Body: io.NopCloser(bytes.NewBufferString(`{"ok":true,"result":{"message_id":2}}`)),
+		}, nil
+	}).Once()
+
+	client, err := NewClient(testToken,
+		WithAPIServer("https://api.example.com"),
+		WithMethodEndpoint(sendVideoMethod, "https://media.example.com/gw/"),
+		WithHTTPClient(httpClient),
+	)
+	assert.NoError(t, err)
+
+	_, err = client.SendVideo(context.Background(), 1, FileFromID("test"))
+	assert.NoError(t, err)
+
+	_, err = client.SendMessage(context.Background(), 1, "test")
+	assert.NoError(t, err)
+}
+
+func Test_WithMethodEndpoint(t *testing.T) {
+	t.Parallel()
+
+	client := new(Client)
+
+	assert.ErrorIs(t, WithMethodEndpoint("", "https://api.example.com")(client), ErrEmptyMethod)
+	assert.ErrorIs(t, WithMethodEndpoint(sendVideoMethod, "ftp://api.example.com")(client), ErrIncorrectScheme)
+	assert.ErrorIs(t, WithMethodEndpoint(sendVideoMethod, "https://")(client), ErrEmptyHost)
+}
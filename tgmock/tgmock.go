@@ -0,0 +1,21 @@
+// Package tgmock provides a testify mock of the tg.TG interface
+// for the tests of the code using the client.
+//
+// MockTG is generated by mockery (see .mockery.yml), the variadic options
+// are passed to the mock as one slice argument, match them with mock.Anything.
+package tgmock
+
+import (
+	"github.com/a-kataev/tg"
+	"github.com/stretchr/testify/mock"
+)
+
+var _ tg.TG = (*MockTG)(nil)
+
+// New creates the mock and asserts the expectations at the end of the test.
+func New(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTG {
+	return NewMockTG(t)
+}
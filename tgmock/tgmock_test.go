@@ -0,0 +1,62 @@
+//nolint:exhaustruct
+package tgmock_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/a-kataev/tg"
+	"github.com/a-kataev/tg/tgmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var errTest = errors.New("test")
+
+// notify is the code under test, it depends only on the narrow interface.
+func notify(ctx context.Context, sender tg.MessageSender, chatID int64, text string) (int64, error) {
+	msg, err := sender.SendMessage(ctx, chatID, text, tg.DisableNotificationSendOption(true))
+	if err != nil {
+		return 0, err //nolint:wrapcheck
+	}
+
+	return msg.MessageID, nil
+}
+
+func Test_MockTG_SendMessage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc      string
+		msg       *tg.Message
+		err       error
+		messageID int64
+	}{
+		{
+			desc:      "sent",
+			msg:       &tg.Message{MessageID: 42},
+			messageID: 42,
+		},
+		{
+			desc: errTest.Error(),
+			err:  errTest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			client := tgmock.New(t)
+			client.On("SendMessage", mock.Anything, int64(1), "hello", mock.Anything).
+				Return(test.msg, test.err).
+				Once()
+
+			messageID, err := notify(context.Background(), client, 1, "hello")
+
+			assert.ErrorIs(t, err, test.err)
+			assert.Equal(t, test.messageID, messageID)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package tg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// TokenSource returns the bot token of a request, it's called for every
+// request (including the retries), so a token rotated by e.g. a secrets
+// manager is used without creating a new client. Token should be fast
+// (cache the token) and safe for concurrent use.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+type staticTokenSource string
+
+func (ts staticTokenSource) Token(context.Context) (string, error) {
+	return string(ts), nil
+}
+
+// StaticTokenSource returns a TokenSource which always returns token.
+func StaticTokenSource(token string) TokenSource {
+	return staticTokenSource(token)
+}
+
+var ErrTokenSourceNil = errors.New("token source is nil")
+
+// WithTokenSource makes the client get the token from ts for every request,
+// the token passed to NewClient must be empty.
+func WithTokenSource(ts TokenSource) Option {
+	return func(cl *Client) error {
+		if ts == nil {
+			return ErrTokenSourceNil
+		}
+
+		cl.tokenSource = ts
+
+		return nil
+	}
+}
+
+// botURL returns the url of path (a method or a file path) on the server
+// endpoint with the current token, the endpoint is used as is if the client
+// has no token source.
+func (c *Client) botURL(ctx context.Context, endpoint, path string) (string, error) {
+	if c.tokenSource == nil {
+		return endpoint + path, nil
+	}
+
+	token, err := c.tokenSource.Token(ctx)
+	if err != nil {
+		return "", fmt.Errorf("token: %w", err)
+	}
+
+	if !regexpToken.MatchString(token) {
+		return "", fmt.Errorf("token: %w", ErrIncorrentToken)
+	}
+
+	return endpoint + "/bot" + token + "/" + path, nil
+}
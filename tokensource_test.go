@@ -0,0 +1,110 @@
+//nolint:exhaustruct
+package tg
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type rotatingTokenSource struct {
+	tokens []string
+	calls  atomic.Int64
+	err    error
+}
+
+func (ts *rotatingTokenSource) Token(context.Context) (string, error) {
+	if ts.err != nil {
+		return "", ts.err
+	}
+
+	return ts.tokens[int(ts.calls.Add(1)-1)%len(ts.tokens)], nil
+}
+
+func Test_NewClient_TokenSource(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		token  string
+		opts   []Option
+		result error
+	}{
+		{
+			desc:   ErrTokenSourceNil.Error(),
+			opts:   []Option{WithTokenSource(nil)},
+			result: fmt.Errorf("Client: %w", ErrTokenSourceNil),
+		},
+		{
+			desc:   ErrIncorrentToken.Error(),
+			token:  testToken,
+			opts:   []Option{WithTokenSource(StaticTokenSource(testToken))},
+			result: fmt.Errorf("Client: %w", ErrIncorrentToken),
+		},
+		{
+			desc:   "empty_token",
+			result: fmt.Errorf("Client: %w", ErrIncorrentToken),
+		},
+		{
+			desc:   "nil_result",
+			opts:   []Option{WithTokenSource(StaticTokenSource(testToken))},
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewClient(test.token, test.opts...)
+
+			assert.Equal(t, test.result, err)
+		})
+	}
+}
+
+func Test_Client_API_TokenSource(t *testing.T) {
+	t.Parallel()
+
+	httpClient := newMockHTTPClient(t)
+
+	for _, token := range []string{"1:first", "1:second"} {
+		httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+			return req.URL.String() == "http://tg.test/bot"+token+"/getMe"
+		})).Return(func(*http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"ok":true,"result":{"id":1}}`)),
+			}, nil
+		}).Once()
+	}
+
+	ts := &rotatingTokenSource{tokens: []string{"1:first", "1:second", "bad"}}
+
+	client, err := NewClient("",
+		WithAPIServer("http://tg.test"),
+		WithHTTPClient(httpClient),
+		WithTokenSource(ts),
+	)
+	assert.NoError(t, err)
+
+	for range 2 {
+		_, err := client.GetMe(context.Background())
+		assert.NoError(t, err)
+	}
+
+	_, err = client.GetMe(context.Background())
+	assert.ErrorIs(t, err, ErrIncorrentToken)
+
+	ts.err = errTest
+
+	_, err = client.GetMe(context.Background())
+	assert.ErrorIs(t, err, errTest)
+}
@@ -0,0 +1,73 @@
+package tg
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// MessageUpdater edits the same message again and again (e.g. a status board),
+// the edit is skipped if the text and the options are the same as the last
+// ones and the last message is returned, so "message is not modified" is
+// never sent. It's safe for concurrent use, the edits are serialized.
+type MessageUpdater struct {
+	editor    MessageEditor
+	chatID    int64
+	messageID int64
+
+	mu   sync.Mutex
+	last string
+	msg  *Message
+}
+
+func NewMessageUpdater(editor MessageEditor, chatID, messageID int64) (*MessageUpdater, error) {
+	if chatID == 0 {
+		return nil, fmt.Errorf("MessageUpdater: %w", ErrEmptyChatID)
+	}
+
+	if messageID <= 0 {
+		return nil, fmt.Errorf("MessageUpdater: %w", ErrIncorrectMessageID)
+	}
+
+	return &MessageUpdater{
+		editor:    editor,
+		chatID:    chatID,
+		messageID: messageID,
+	}, nil
+}
+
+// Update edits the message if the text or the options are changed since the
+// last successful Update, "message is not modified" (e.g. the message was
+// sent with the same text) is treated as success.
+func (u *MessageUpdater) Update(ctx context.Context, text string, opts ...EditOption) (*Message, error) {
+	key, err := json.Marshal(newEditMessage(u.chatID, u.messageID, text, opts...))
+	if err != nil {
+		return nil, fmt.Errorf("MessageUpdater: %w", err)
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if u.msg != nil && u.last == string(key) {
+		msg := *u.msg
+
+		return &msg, nil
+	}
+
+	msg, err := u.editor.EditMessage(ctx, u.chatID, u.messageID, text, opts...)
+	if err != nil {
+		if !IsMessageNotModified(err) {
+			return nil, fmt.Errorf("MessageUpdater: %w", err)
+		}
+
+		msg = &Message{MessageID: u.messageID, Chat: Chat{ID: u.chatID}, Text: text}
+	}
+
+	u.last = string(key)
+	u.msg = msg
+
+	result := *msg
+
+	return &result, nil
+}
@@ -0,0 +1,91 @@
+//nolint:exhaustruct
+package tg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_NewMessageUpdater(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewMessageUpdater(new(Client), 0, 1)
+	assert.ErrorIs(t, err, ErrEmptyChatID)
+
+	_, err = NewMessageUpdater(new(Client), 1, 0)
+	assert.ErrorIs(t, err, ErrIncorrectMessageID)
+}
+
+func Test_MessageUpdater_Update(t *testing.T) {
+	t.Parallel()
+
+	var edits []string
+
+	// the bodies are read in Return, a matcher per text would consume them
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.Anything).Return(func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+
+		msg := struct {
+			Text string `json:"text"`
+		}{}
+		_ = json.Unmarshal(body, &msg)
+
+		edits = append(edits, msg.Text)
+
+		resp := `{"ok":true,"result":{"message_id":2,"text":"` + msg.Text + `"}}`
+		if msg.Text == "third" {
+			resp = `{"ok":false,"error_code":400,"description":"Bad Request: message is not modified"}`
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewBufferString(resp)),
+		}, nil
+	})
+
+	client := new(Client)
+	client.http = httpClient
+
+	updater, err := NewMessageUpdater(client, 1, 2)
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+
+	for range 10 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			msg, err := updater.Update(context.Background(), "first")
+
+			assert.NoError(t, err)
+			assert.Equal(t, "first", msg.Text)
+		}()
+	}
+
+	wg.Wait()
+
+	msg, err := updater.Update(context.Background(), "second")
+	assert.NoError(t, err)
+	assert.Equal(t, "second", msg.Text)
+
+	msg, err = updater.Update(context.Background(), "third")
+	assert.NoError(t, err)
+	assert.Equal(t, "third", msg.Text)
+
+	msg, err = updater.Update(context.Background(), "third")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), msg.MessageID)
+
+	assert.Equal(t, []string{"first", "second", "third"}, edits)
+}
@@ -0,0 +1,404 @@
+package tg
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+type UpdateType string
+
+const (
+	MessageUpdateType                 = "message"
+	EditedMessageUpdateType           = "edited_message"
+	ChannelPostUpdateType             = "channel_post"
+	EditedChannelPostUpdateType       = "edited_channel_post"
+	BusinessConnectionUpdateType      = "business_connection"
+	BusinessMessageUpdateType         = "business_message"
+	EditedBusinessMessageUpdateType   = "edited_business_message"
+	DeletedBusinessMessagesUpdateType = "deleted_business_messages"
+	MessageReactionUpdateType         = "message_reaction"
+	MessageReactionCountUpdateType    = "message_reaction_count"
+	InlineQueryUpdateType             = "inline_query"
+	ChosenInlineResultUpdateType      = "chosen_inline_result"
+	CallbackQueryUpdateType           = "callback_query"
+	ShippingQueryUpdateType           = "shipping_query"
+	PreCheckoutQueryUpdateType        = "pre_checkout_query"
+	PurchasedPaidMediaUpdateType      = "purchased_paid_media"
+	PollUpdateType                    = "poll"
+	PollAnswerUpdateType              = "poll_answer"
+	MyChatMemberUpdateType            = "my_chat_member"
+	ChatMemberUpdateType              = "chat_member"
+	ChatJoinRequestUpdateType         = "chat_join_request"
+	ChatBoostUpdateType               = "chat_boost"
+	RemovedChatBoostUpdateType        = "removed_chat_boost"
+)
+
+var updateTypeList = []UpdateType{ //nolint:gochecknoglobals
+	MessageUpdateType,
+	EditedMessageUpdateType,
+	ChannelPostUpdateType,
+	EditedChannelPostUpdateType,
+	BusinessConnectionUpdateType,
+	BusinessMessageUpdateType,
+	EditedBusinessMessageUpdateType,
+	DeletedBusinessMessagesUpdateType,
+	MessageReactionUpdateType,
+	MessageReactionCountUpdateType,
+	InlineQueryUpdateType,
+	ChosenInlineResultUpdateType,
+	CallbackQueryUpdateType,
+	ShippingQueryUpdateType,
+	PreCheckoutQueryUpdateType,
+	PurchasedPaidMediaUpdateType,
+	PollUpdateType,
+	PollAnswerUpdateType,
+	MyChatMemberUpdateType,
+	ChatMemberUpdateType,
+	ChatJoinRequestUpdateType,
+	ChatBoostUpdateType,
+	RemovedChatBoostUpdateType,
+}
+
+var ErrUnknownUpdateType = errors.New("unknown update type")
+
+func (t UpdateType) Validate() error {
+	if !slices.Contains(updateTypeList, t) {
+		return fmt.Errorf("%w %q", ErrUnknownUpdateType, string(t))
+	}
+
+	return nil
+}
+
+type Update struct {
+	UpdateID          int64      `json:"update_id"`
+	Type              UpdateType `json:"-"`
+	Message           *Message   `json:"message,omitempty"`
+	EditedMessage     *Message   `json:"edited_message,omitempty"`
+	ChannelPost       *Message   `json:"channel_post,omitempty"`
+	EditedChannelPost *Message   `json:"edited_channel_post,omitempty"`
+}
+
+// UnmarshalJSON fills Type with the name of the update field, including
+// types which have no field in Update.
+func (u *Update) UnmarshalJSON(data []byte) error {
+	type update Update
+
+	if err := json.Unmarshal(data, (*update)(u)); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	fields := make(map[string]json.RawMessage)
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	for name := range fields {
+		if name != "update_id" {
+			u.Type = UpdateType(name)
+
+			break
+		}
+	}
+
+	return nil
+}
+
+const MaxUpdatesLimit int = 100
+
+type GetUpdates struct {
+	Offset         int64        `json:"offset,omitempty"`
+	Limit          int          `json:"limit,omitempty"`
+	Timeout        int          `json:"timeout,omitempty"`
+	AllowedUpdates []UpdateType `json:"allowed_updates,omitempty"`
+}
+
+var (
+	ErrIncorrectLimit   = errors.New("incorrect limit")
+	ErrIncorrectTimeout = errors.New("incorrect timeout")
+)
+
+func (gu *GetUpdates) Validate() error {
+	if gu.Limit < 0 || gu.Limit > MaxUpdatesLimit {
+		return ErrIncorrectLimit
+	}
+
+	if gu.Timeout < 0 {
+		return ErrIncorrectTimeout
+	}
+
+	for _, updateType := range gu.AllowedUpdates {
+		if err := updateType.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type GetUpdatesOption func(*GetUpdates)
+
+func NewGetUpdates(opts ...GetUpdatesOption) (*GetUpdates, error) {
+	gu := new(GetUpdates)
+
+	for _, opt := range opts {
+		opt(gu)
+	}
+
+	if err := gu.Validate(); err != nil {
+		return nil, fmt.Errorf("GetUpdates: %w", err)
+	}
+
+	return gu, nil
+}
+
+func OffsetGetUpdatesOption(offset int64) GetUpdatesOption {
+	return func(gu *GetUpdates) {
+		gu.Offset = offset
+	}
+}
+
+func LimitGetUpdatesOption(limit int) GetUpdatesOption {
+	return func(gu *GetUpdates) {
+		gu.Limit = limit
+	}
+}
+
+// TimeoutGetUpdatesOption sets the long polling timeout in seconds,
+// the HTTP client timeout must be greater than it.
+func TimeoutGetUpdatesOption(timeout int) GetUpdatesOption {
+	return func(gu *GetUpdates) {
+		gu.Timeout = timeout
+	}
+}
+
+// AllowedUpdatesGetUpdatesOption sets the list of the update types to receive,
+// an empty list means all types except chat_member, message_reaction
+// and message_reaction_count.
+func AllowedUpdatesGetUpdatesOption(updateTypes []UpdateType) GetUpdatesOption {
+	return func(gu *GetUpdates) {
+		gu.AllowedUpdates = updateTypes
+	}
+}
+
+const getUpdatesMethod = "getUpdates"
+
+func (c *Client) GetUpdates(ctx context.Context, opts ...GetUpdatesOption) ([]Update, error) {
+	req, err := NewGetUpdates(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("GetUpdates: %w", err)
+	}
+
+	resp := make([]Update, 0)
+
+	if err := c.API(ctx, getUpdatesMethod, req, &resp); err != nil {
+		return nil, fmt.Errorf("GetUpdates: %w", err)
+	}
+
+	return resp, nil
+}
+
+type UpdateHandler func(ctx context.Context, update Update) error
+
+type pollConfig struct {
+	offset         int64
+	limit          int
+	timeout        int
+	allowedUpdates []UpdateType
+	backoff        time.Duration
+	maxBackoff     time.Duration
+	skipFailed     bool
+	store          OffsetStore
+}
+
+type PollOption func(*pollConfig)
+
+// OffsetPollOption sets the identifier of the first update to be received,
+// it is used if the offset store has no offset yet.
+func OffsetPollOption(offset int64) PollOption {
+	return func(pc *pollConfig) {
+		pc.offset = offset
+	}
+}
+
+func LimitPollOption(limit int) PollOption {
+	return func(pc *pollConfig) {
+		pc.limit = limit
+	}
+}
+
+// TimeoutPollOption sets the long polling timeout in seconds (default 1),
+// the HTTP client timeout must be greater than it.
+func TimeoutPollOption(timeout int) PollOption {
+	return func(pc *pollConfig) {
+		pc.timeout = timeout
+	}
+}
+
+func AllowedUpdatesPollOption(updateTypes []UpdateType) PollOption {
+	return func(pc *pollConfig) {
+		pc.allowedUpdates = updateTypes
+	}
+}
+
+// OffsetStorePollOption sets the store of the offset, which is loaded on start
+// and saved after every processed batch (default MemoryOffsetStore).
+func OffsetStorePollOption(store OffsetStore) PollOption {
+	return func(pc *pollConfig) {
+		pc.store = store
+	}
+}
+
+// BackoffPollOption sets the initial and the maximum delay between
+// retries after an error, the delay doubles on every consecutive error.
+// Both must be positive and the maximum not less than the initial one.
+func BackoffPollOption(backoff, maxBackoff time.Duration) PollOption {
+	return func(pc *pollConfig) {
+		pc.backoff = backoff
+		pc.maxBackoff = maxBackoff
+	}
+}
+
+// SkipFailedPollOption commits the offset past an update even if its handler
+// returned an error, by default the update is received again after a backoff.
+func SkipFailedPollOption(skip bool) PollOption {
+	return func(pc *pollConfig) {
+		pc.skipFailed = skip
+	}
+}
+
+var (
+	ErrHandlerNil       = errors.New("handler is nil")
+	ErrIncorrectBackoff = errors.New("incorrect backoff")
+)
+
+//nolint:gomnd
+func newPollConfig(opts ...PollOption) *pollConfig {
+	pc := &pollConfig{
+		timeout:    1,
+		backoff:    time.Second,
+		maxBackoff: 30 * time.Second,
+	}
+
+	for _, opt := range opts {
+		opt(pc)
+	}
+
+	if pc.store == nil {
+		pc.store = new(MemoryOffsetStore)
+	}
+
+	return pc
+}
+
+// validate rejects a zero backoff, which would retry a failing getUpdates
+// in a hot loop.
+func (pc *pollConfig) validate() error {
+	if pc.backoff <= 0 || pc.maxBackoff < pc.backoff {
+		return ErrIncorrectBackoff
+	}
+
+	return nil
+}
+
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err() //nolint:wrapcheck
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Poll receives updates with getUpdates and calls handler for each of them
+// until ctx is done. The offset is advanced only past the updates which were
+// handled without an error (see SkipFailedPollOption).
+func (c *Client) Poll(ctx context.Context, handler UpdateHandler, opts ...PollOption) error {
+	if handler == nil {
+		return fmt.Errorf("Poll: %w", ErrHandlerNil)
+	}
+
+	pc := newPollConfig(opts...)
+	if err := pc.validate(); err != nil {
+		return fmt.Errorf("Poll: %w", err)
+	}
+
+	backoff := pc.backoff
+
+	offset, err := pc.store.Load()
+	if err != nil {
+		return fmt.Errorf("Poll: %w", err)
+	}
+
+	if offset != 0 {
+		pc.offset = offset
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("Poll: %w", err)
+		}
+
+		failed := false
+
+		// the request is made with ctx, so its cancellation aborts the
+		// long poll at once instead of waiting for the polling timeout
+		updates, err := c.GetUpdates(ctx,
+			OffsetGetUpdatesOption(pc.offset),
+			LimitGetUpdatesOption(pc.limit),
+			TimeoutGetUpdatesOption(pc.timeout),
+			AllowedUpdatesGetUpdatesOption(pc.allowedUpdates),
+		)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return fmt.Errorf("Poll: %w", ctxErr)
+			}
+
+			if errors.Is(err, ErrIncorrectLimit) || errors.Is(err, ErrIncorrectTimeout) ||
+				errors.Is(err, ErrUnknownUpdateType) {
+				return fmt.Errorf("Poll: %w", err)
+			}
+
+			failed = true
+		}
+
+		offset := pc.offset
+
+		for _, update := range updates {
+			if err := handler(ctx, update); err != nil && !pc.skipFailed {
+				failed = true
+
+				break
+			}
+
+			pc.offset = update.UpdateID + 1
+		}
+
+		if pc.offset != offset {
+			if err := pc.store.Save(pc.offset); err != nil {
+				failed = true
+			}
+		}
+
+		if !failed {
+			backoff = pc.backoff
+
+			continue
+		}
+
+		if err := sleep(ctx, backoff); err != nil {
+			return fmt.Errorf("Poll: %w", err)
+		}
+
+		c.stats.retries.Add(1)
+
+		backoff = min(backoff*2, pc.maxBackoff) //nolint:gomnd
+	}
+}
@@ -0,0 +1,297 @@
+//nolint:exhaustruct
+package tg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_UpdateType_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc       string
+		updateType UpdateType
+		result     error
+	}{
+		{
+			desc:       ErrUnknownUpdateType.Error(),
+			updateType: "messages",
+			result:     fmt.Errorf("%w %q", ErrUnknownUpdateType, "messages"),
+		},
+		{
+			desc:       "empty",
+			updateType: "",
+			result:     fmt.Errorf("%w %q", ErrUnknownUpdateType, ""),
+		},
+		{
+			desc:       "case",
+			updateType: "Callback_Query",
+			result:     fmt.Errorf("%w %q", ErrUnknownUpdateType, "Callback_Query"),
+		},
+	}
+
+	for _, updateType := range updateTypeList {
+		tests = append(tests, struct {
+			desc       string
+			updateType UpdateType
+			result     error
+		}{
+			desc:       string(updateType),
+			updateType: updateType,
+			result:     nil,
+		})
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.result, test.updateType.Validate())
+		})
+	}
+}
+
+func Test_GetUpdates_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		msg    func() *GetUpdates
+		result error
+	}{
+		{
+			desc:   ErrIncorrectLimit.Error(),
+			msg:    func() *GetUpdates { return &GetUpdates{Limit: MaxUpdatesLimit + 1} },
+			result: ErrIncorrectLimit,
+		},
+		{
+			desc:   ErrIncorrectTimeout.Error(),
+			msg:    func() *GetUpdates { return &GetUpdates{Timeout: -1} },
+			result: ErrIncorrectTimeout,
+		},
+		{
+			desc: ErrUnknownUpdateType.Error(),
+			msg: func() *GetUpdates {
+				return &GetUpdates{AllowedUpdates: []UpdateType{MessageUpdateType, "test"}}
+			},
+			result: fmt.Errorf("%w %q", ErrUnknownUpdateType, "test"),
+		},
+		{
+			desc: "nil_result",
+			msg: func() *GetUpdates {
+				return &GetUpdates{
+					Offset:         1,
+					Limit:          1,
+					AllowedUpdates: []UpdateType{MessageUpdateType, ChannelPostUpdateType},
+				}
+			},
+			result: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.msg().Validate(), test.result)
+		})
+	}
+}
+
+func Test_Update_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		data   string
+		result UpdateType
+	}{
+		{
+			desc:   "message",
+			data:   `{"update_id":1,"message":{"message_id":1,"chat":{"id":1}}}`,
+			result: MessageUpdateType,
+		},
+		{
+			desc:   "unsupported",
+			data:   `{"update_id":1,"poll_answer":{}}`,
+			result: PollAnswerUpdateType,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			update := new(Update)
+
+			assert.NoError(t, json.Unmarshal([]byte(test.data), update))
+			assert.Equal(t, test.result, update.Type)
+		})
+	}
+}
+
+// updatesServer replies to getUpdates with the updates starting from the requested offset.
+func updatesServer(t *testing.T, updates []Update, offsets *[]int64) func(*http.Request) (*http.Response, error) {
+	t.Helper()
+
+	return func(req *http.Request) (*http.Response, error) {
+		gu := new(GetUpdates)
+
+		if err := json.NewDecoder(req.Body).Decode(gu); err != nil {
+			return nil, err
+		}
+
+		*offsets = append(*offsets, gu.Offset)
+
+		result := make([]Update, 0)
+
+		for _, update := range updates {
+			if update.UpdateID >= gu.Offset {
+				result = append(result, update)
+			}
+		}
+
+		body, _ := json.Marshal(map[string]any{"ok": true, "result": result}) //nolint:errchkjson
+
+		return &http.Response{Body: io.NopCloser(bytes.NewBuffer(body))}, nil
+	}
+}
+
+func Test_Client_Poll(t *testing.T) {
+	t.Parallel()
+
+	updates := []Update{{UpdateID: 10}, {UpdateID: 11}, {UpdateID: 12}}
+	offsets := make([]int64, 0)
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.Anything).Return(updatesServer(t, updates, &offsets))
+
+	client := new(Client)
+	client.http = httpClient
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	handled := make([]int64, 0)
+	failed := false
+
+	err := client.Poll(ctx, func(_ context.Context, update Update) error {
+		if update.UpdateID == 11 && !failed {
+			failed = true
+
+			return errTest
+		}
+
+		handled = append(handled, update.UpdateID)
+
+		if update.UpdateID == 12 {
+			cancel()
+		}
+
+		return nil
+	}, BackoffPollOption(time.Millisecond, time.Millisecond))
+
+	assert.Equal(t, fmt.Errorf("Poll: %w", context.Canceled), err)
+	assert.Equal(t, []int64{10, 11, 12}, handled)
+	assert.Equal(t, []int64{0, 11}, offsets)
+	assert.Equal(t, uint64(1), client.Stats().Retries)
+}
+
+func Test_Client_Poll_CancelLongPoll(t *testing.T) {
+	t.Parallel()
+
+	started := make(chan struct{})
+
+	httpClient := newMockHTTPClient(t)
+	httpClient.On("Do", mock.Anything).Return(func(req *http.Request) (*http.Response, error) {
+		close(started)
+
+		// a long poll without updates ends only by the request context
+		<-req.Context().Done()
+
+		return nil, req.Context().Err()
+	}).Once()
+
+	client := new(Client)
+	client.http = httpClient
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	start := time.Now()
+
+	err := client.Poll(ctx, func(context.Context, Update) error {
+		return nil
+	}, TimeoutPollOption(60), BackoffPollOption(time.Minute, time.Minute))
+
+	assert.Equal(t, fmt.Errorf("Poll: %w", context.Canceled), err)
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, uint64(0), client.Stats().Retries)
+}
+
+func Test_Client_Poll_HandlerNil(t *testing.T) {
+	t.Parallel()
+
+	client := new(Client)
+
+	assert.True(t, errors.Is(client.Poll(context.Background(), nil), ErrHandlerNil))
+}
+
+func Test_Client_Poll_IncorrectBackoff(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc       string
+		backoff    time.Duration
+		maxBackoff time.Duration
+	}{
+		{
+			desc:       "zero",
+			backoff:    0,
+			maxBackoff: 0,
+		},
+		{
+			desc:       "negative",
+			backoff:    -time.Second,
+			maxBackoff: time.Second,
+		},
+		{
+			desc:       "max_less",
+			backoff:    time.Second,
+			maxBackoff: time.Millisecond,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			// no request is expected, the mock fails on any call
+			client := new(Client)
+			client.http = newMockHTTPClient(t)
+
+			err := client.Poll(context.Background(), func(context.Context, Update) error {
+				return nil
+			}, BackoffPollOption(test.backoff, test.maxBackoff))
+
+			assert.Equal(t, fmt.Errorf("Poll: %w", ErrIncorrectBackoff), err)
+			assert.Equal(t, uint64(0), client.Stats().Retries)
+		})
+	}
+}